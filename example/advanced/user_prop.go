@@ -1,18 +1,37 @@
 // Code generated by github.com/hidori/go-genprop/cmd/genprop DO NOT EDIT.
 package advanced
 
+// GetID returns the id.
+//
+// Read-only ID field
 func (t *User) GetID() int {
 	return t.id
 }
+
+// GetName returns the name.
+//
+// Name with both getter and setter
 func (t *User) GetName() string {
 	return t.name
 }
+
+// SetName sets the name.
+//
+// Name with both getter and setter
 func (t *User) SetName(v string) {
 	t.name = v
 }
+
+// GetEmail returns the email.
+//
+// Email with private setter and validation
 func (t *User) GetEmail() string {
 	return t.email
 }
+
+// setEmail validates and sets the email.
+//
+// Email with private setter and validation
 func (t *User) setEmail(v string) error {
 	err := validateFieldValue("email", v, "required,email")
 	if err != nil {
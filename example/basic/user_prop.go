@@ -1,12 +1,23 @@
 // Code generated by github.com/hidori/go-genprop/cmd/genprop DO NOT EDIT.
 package basic
 
+// GetID returns the id.
+//
+// Read-only ID field
 func (t *User) GetID() int {
 	return t.id
 }
+
+// GetName returns the name.
+//
+// Name with both getter and setter
 func (t *User) GetName() string {
 	return t.name
 }
+
+// SetName sets the name.
+//
+// Name with both getter and setter
 func (t *User) SetName(v string) {
 	t.name = v
 }
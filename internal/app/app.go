@@ -1,11 +1,14 @@
 package app
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
+	"github.com/hidori/go-genprop/internal/app/config"
 	"github.com/hidori/go-genprop/internal/app/formatter"
 	"github.com/hidori/go-genprop/internal/app/generator"
 	"github.com/hidori/go-genprop/internal/app/parser"
@@ -15,16 +18,22 @@ import (
 
 // Run executes the CLI application with command line arguments.
 func Run(args []string) error {
+	if len(args) > 0 && args[0] == "config" {
+		return runConfig(os.Stdout, args[1:])
+	}
+
 	flagSet := flag.NewFlagSet("genprop", flag.ExitOnError)
-	initialismFlagFS := flagSet.String("initialism", "id,url,api", "specify names to which initialism should be applied")
-	validationFuncFlagFS := flagSet.String("validation-func", "validateFieldValue", "specify validation func name")
-	validationTagFlagFS := flagSet.String("validation-tag", "validate", "specify validation tag name")
+	settingFlagsFS := defineSettingFlags(flagSet)
+	fakesFlagFS := flagSet.String("fakes", "", "write fakes of the generated interfaces to the specified file")
+	emitTestsFlagFS := flagSet.Bool("emit-tests", false, "write unit tests of the generated accessors to <FILE>_prop_test.go")
 	versionFlagFS := flagSet.Bool("version", false, "show version information")
 
 	flagSet.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage: genprop [flags] <FILE>\n")
+		fmt.Fprintf(os.Stderr, "       genprop config [flags] <FILE>\n")
 		fmt.Fprintf(os.Stderr, "\nA Go code generator that automatically creates getter and setter methods "+
 			"for private struct fields based on struct tags.\n\n")
+		fmt.Fprintf(os.Stderr, "Settings are read from the nearest %s above <FILE> and overridden by flags.\n\n", config.FileName)
 		fmt.Fprintf(os.Stderr, "Flags:\n")
 		flagSet.PrintDefaults()
 	}
@@ -39,30 +48,192 @@ func Run(args []string) error {
 		return nil
 	}
 
+	fileName, err := fileArgOf(flagSet)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(fileName, settingFlagsFS.settings(flagSet))
+	if err != nil {
+		return err
+	}
+
+	err = generate(os.Stdout, fileName, cfg)
+	if err != nil {
+		return err
+	}
+
+	if *fakesFlagFS != "" {
+		err = writeFile(*fakesFlagFS, func(writer io.Writer) error {
+			return generateFakes(writer, fileName, cfg)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if *emitTestsFlagFS {
+		err = writeFile(testFileNameOf(fileName), func(writer io.Writer) error {
+			return generateTests(writer, fileName, cfg)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func runConfig(writer io.Writer, args []string) error {
+	flagSet := flag.NewFlagSet("genprop config", flag.ExitOnError)
+	settingFlagsFS := defineSettingFlags(flagSet)
+
+	flagSet.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: genprop config [flags] <FILE>\n")
+		fmt.Fprintf(os.Stderr, "\nPrints the effective configuration for <FILE> as JSON.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flagSet.PrintDefaults()
+	}
+
+	err := flagSet.Parse(args)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	fileName, err := fileArgOf(flagSet)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(fileName, settingFlagsFS.settings(flagSet))
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, _ = fmt.Fprintln(writer, string(data))
+
+	return nil
+}
+
+type settingFlags struct {
+	tag            *string
+	initialism     *string
+	validationFunc *string
+	validationTag  *string
+	nilSafe        *bool
+	receiver       *string
+}
+
+func defineSettingFlags(flagSet *flag.FlagSet) *settingFlags {
+	defaults := config.Default()
+
+	return &settingFlags{
+		tag: flagSet.String("tag", defaults.Tag,
+			"specify struct tag names in order of precedence, e.g. \"property,prop\""),
+		initialism: flagSet.String("initialism", defaults.Initialism,
+			"specify names to which initialism should be applied"),
+		validationFunc: flagSet.String("validation-func", defaults.ValidationFunc,
+			"specify validation func name"),
+		validationTag: flagSet.String("validation-tag", defaults.ValidationTag,
+			"specify validation tag name"),
+		nilSafe: flagSet.Bool("nil-safe", defaults.NilSafe,
+			"generate getters that return zero values for nil receivers"),
+		receiver: flagSet.String("receiver", defaults.Receiver,
+			"specify receiver name (default: the name used by existing methods, or \"t\")"),
+	}
+}
+
+// settings returns the settings of the flags given on the command line, so that flags left
+// at their defaults do not override the configuration file.
+func (f *settingFlags) settings(flagSet *flag.FlagSet) config.Settings {
+	var settings config.Settings
+
+	flagSet.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "tag":
+			settings.Tag = f.tag
+		case "initialism":
+			settings.Initialism = f.initialism
+		case "validation-func":
+			settings.ValidationFunc = f.validationFunc
+		case "validation-tag":
+			settings.ValidationTag = f.validationTag
+		case "nil-safe":
+			settings.NilSafe = f.nilSafe
+		case "receiver":
+			settings.Receiver = f.receiver
+		}
+	})
+
+	return settings
+}
+
+func fileArgOf(flagSet *flag.FlagSet) (string, error) {
 	parsedArgs := flagSet.Args()
 
 	if len(parsedArgs) == 0 {
 		flagSet.Usage()
 
-		return errors.New("file argument is required")
+		return "", errors.New("file argument is required")
 	}
 
 	if len(parsedArgs) != 1 {
 		flagSet.Usage()
 
-		return errors.New("exactly one file argument is required")
+		return "", errors.New("exactly one file argument is required")
 	}
 
-	return generate(os.Stdout, parsedArgs[0], *initialismFlagFS, *validationFuncFlagFS, *validationTagFlagFS)
+	return parsedArgs[0], nil
 }
 
-func generate(writer io.Writer, fileName string, initialismFlag, validationFuncFlag, validationTagFlag string) error {
+func loadConfig(fileName string, settings config.Settings) (config.Config, error) {
+	cfg, err := config.Load(fileName)
+	if err != nil {
+		return config.Config{}, errors.Wrap(err, "failed to load config")
+	}
+
+	cfg.Apply(settings)
+
+	return cfg, nil
+}
+
+func writeFile(fileName string, write func(writer io.Writer) error) (err error) {
+	file, err := os.Create(fileName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create file: %s", fileName)
+	}
+
+	defer func() {
+		closeErr := file.Close()
+		if err == nil && closeErr != nil {
+			err = errors.WithStack(closeErr)
+		}
+	}()
+
+	return write(file)
+}
+
+func testFileNameOf(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + "_prop_test.go"
+}
+
+func generate(writer io.Writer, fileName string, cfg config.Config) error {
 	file, err := parser.ParseFile(fileName)
 	if err != nil {
 		return errors.Wrap(err, "failed to parse file")
 	}
 
-	decls, err := generator.GenerateCode(file, initialismFlag, validationFuncFlag, validationTagFlag)
+	packageFiles, err := parser.ParsePackageFiles(fileName, file.Name.Name)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse package files")
+	}
+
+	decls, err := generator.GenerateCode(file, packageFiles, cfg)
 	if err != nil {
 		return errors.Wrap(err, "failed to generate code")
 	}
@@ -74,3 +245,46 @@ func generate(writer io.Writer, fileName string, initialismFlag, validationFuncF
 
 	return nil
 }
+
+func generateFakes(writer io.Writer, fileName string, cfg config.Config) error {
+	file, err := parser.ParseFile(fileName)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse file")
+	}
+
+	packageFiles, err := parser.ParsePackageFiles(fileName, file.Name.Name)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse package files")
+	}
+
+	decls, err := generator.GenerateFakes(file, packageFiles, cfg)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate fakes")
+	}
+
+	err = formatter.WriteOutput(writer, file.Name.Name, decls)
+	if err != nil {
+		return errors.Wrap(err, "failed to write output")
+	}
+
+	return nil
+}
+
+func generateTests(writer io.Writer, fileName string, cfg config.Config) error {
+	file, err := parser.ParseFile(fileName)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse file")
+	}
+
+	decls, err := generator.GenerateTests(file, cfg)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate tests")
+	}
+
+	err = formatter.WriteOutput(writer, file.Name.Name, decls)
+	if err != nil {
+		return errors.Wrap(err, "failed to write output")
+	}
+
+	return nil
+}
@@ -2,8 +2,14 @@ package app
 
 import (
 	"bytes"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/hidori/go-genprop/internal/app/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -46,6 +52,31 @@ func TestRun(t *testing.T) {
 			args:    []string{"genprop", "--initialism", "api,id", "--validation-func", "customValidate", "--validation-tag", "custom", "./testdata//valid_syntax_input.go.txt"},
 			wantErr: false,
 		},
+		{
+			name:    "success: nil-safe flag with valid file",
+			args:    []string{"genprop", "--nil-safe", "./testdata//valid_syntax_input.go.txt"},
+			wantErr: false,
+		},
+		{
+			name:    "success: tag flag with valid file",
+			args:    []string{"genprop", "--tag", "property,prop", "./testdata//valid_syntax_input.go.txt"},
+			wantErr: false,
+		},
+		{
+			name:    "failure: invalid tag flag",
+			args:    []string{"genprop", "--tag", "property,", "./testdata//valid_syntax_input.go.txt"},
+			wantErr: true,
+		},
+		{
+			name:    "success: valid file with config file",
+			args:    []string{"genprop", "./testdata/config/model/user_input.go.txt"},
+			wantErr: false,
+		},
+		{
+			name:    "failure: invalid config file",
+			args:    []string{"genprop", "./testdata/invalid_config/input.go.txt"},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -72,6 +103,8 @@ func TestGenerate(t *testing.T) {
 	tests := []struct {
 		name         string
 		fileName     string
+		nilSafe      bool
+		receiver     string
 		wantErr      bool
 		wantContains []string
 	}{
@@ -86,6 +119,33 @@ func TestGenerate(t *testing.T) {
 				"func (t *TestStruct) SetField(v string)",
 			},
 		},
+		{
+			name:     "success: generates nil-safe getters",
+			fileName: "./testdata//valid_syntax_input.go.txt",
+			nilSafe:  true,
+			wantErr:  false,
+			wantContains: []string{
+				"func (t *TestStruct) GetField() string",
+				"if t == nil {",
+				"var zero string",
+			},
+		},
+		{
+			name:     "success: generates code with receiver name",
+			fileName: "./testdata//valid_syntax_input.go.txt",
+			receiver: "ts",
+			wantErr:  false,
+			wantContains: []string{
+				"func (ts *TestStruct) GetField() string",
+				"return ts.field",
+			},
+		},
+		{
+			name:     "failure: invalid receiver name",
+			fileName: "./testdata//valid_syntax_input.go.txt",
+			receiver: "json",
+			wantErr:  true,
+		},
 		{
 			name:     "failure: non-existent file",
 			fileName: "non_existent_file.go",
@@ -103,7 +163,11 @@ func TestGenerate(t *testing.T) {
 			t.Parallel()
 
 			var buffer bytes.Buffer
-			err := generate(&buffer, tt.fileName, "id,url,api", "validateFieldValue", "validate")
+			cfg := config.Default()
+			cfg.NilSafe = tt.nilSafe
+			cfg.Receiver = tt.receiver
+
+			err := generate(&buffer, tt.fileName, cfg)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -119,3 +183,274 @@ func TestGenerate(t *testing.T) {
 		})
 	}
 }
+
+// TestGenerate_RoundTrip generates code into a temporary module and runs the tests of the module against it.
+func TestGenerate_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping test of generated code in short mode")
+	}
+
+	goCmd, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+
+	tests := []struct {
+		name     string
+		dir      string
+		fileName string
+	}{
+		{
+			name:     "success: text and binary marshaling",
+			dir:      "./testdata/roundtrip",
+			fileName: "value.go",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module roundtrip\n\ngo 1.25\n"), 0o600)
+			require.NoError(t, err)
+
+			fileNames, err := filepath.Glob(filepath.Join(tt.dir, "*.go.txt"))
+			require.NoError(t, err)
+
+			for _, fileName := range fileNames {
+				data, err := os.ReadFile(fileName)
+				require.NoError(t, err)
+
+				err = os.WriteFile(filepath.Join(dir, strings.TrimSuffix(filepath.Base(fileName), ".txt")), data, 0o600)
+				require.NoError(t, err)
+			}
+
+			fileName := filepath.Join(dir, tt.fileName)
+
+			err = writeFile(strings.TrimSuffix(fileName, ".go")+"_prop.go", func(writer io.Writer) error {
+				return generate(writer, fileName, config.Default())
+			})
+			require.NoError(t, err)
+
+			cmd := exec.Command(goCmd, "test", "./...")
+			cmd.Dir = dir
+			cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+
+			output, err := cmd.CombinedOutput()
+			require.NoError(t, err, string(output))
+		})
+	}
+}
+
+func TestRunConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		args         []string
+		wantErr      bool
+		wantContains []string
+	}{
+		{
+			name:    "success: prints default config",
+			args:    []string{"./testdata//valid_syntax_input.go.txt"},
+			wantErr: false,
+			wantContains: []string{
+				`"initialism": "id,url,api"`,
+				`"validationFunc": "validateFieldValue"`,
+				`"validationTag": "validate"`,
+				`"nilSafe": false`,
+			},
+		},
+		{
+			name:    "success: prints config merged with config file",
+			args:    []string{"./testdata/config/model/user_input.go.txt"},
+			wantErr: false,
+			wantContains: []string{
+				`"file": `,
+				`"initialism": "id,api,uuid"`,
+				`"validationFunc": "validateModel"`,
+				`"nilSafe": true`,
+				`"receiver": "m"`,
+			},
+		},
+		{
+			name:    "success: flags override config file",
+			args:    []string{"--validation-func", "validate", "--nil-safe=false", "./testdata/config/model/user_input.go.txt"},
+			wantErr: false,
+			wantContains: []string{
+				`"initialism": "id,api,uuid"`,
+				`"validationFunc": "validate"`,
+				`"nilSafe": false`,
+			},
+		},
+		{
+			name:    "failure: no file argument",
+			args:    []string{},
+			wantErr: true,
+		},
+		{
+			name:    "failure: invalid config file",
+			args:    []string{"./testdata/invalid_config/input.go.txt"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buffer bytes.Buffer
+
+			err := runConfig(&buffer, tt.args)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			output := buffer.String()
+
+			for _, want := range tt.wantContains {
+				assert.Contains(t, output, want)
+			}
+		})
+	}
+}
+
+func TestGenerateFakes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		fileName     string
+		wantErr      bool
+		wantContains []string
+	}{
+		{
+			name:     "success: generates fakes for interface directive",
+			fileName: "./testdata//interface_input.go.txt",
+			wantErr:  false,
+			wantContains: []string{
+				"// Code generated by",
+				"package test",
+				"type FakeTestStructReader struct",
+				"func (t *FakeTestStructReader) GetField() string",
+				"type FakeTestStructWriter struct",
+				"func (t *FakeTestStructWriter) SetField(v string)",
+			},
+		},
+		{
+			name:     "failure: non-existent file",
+			fileName: "non_existent_file.go",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buffer bytes.Buffer
+
+			err := generateFakes(&buffer, tt.fileName, config.Default())
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			output := buffer.String()
+
+			for _, want := range tt.wantContains {
+				assert.Contains(t, output, want)
+			}
+		})
+	}
+}
+
+func TestGenerateTests(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		fileName     string
+		wantErr      bool
+		wantContains []string
+	}{
+		{
+			name:     "success: generates tests for accessors",
+			fileName: "./testdata//valid_syntax_input.go.txt",
+			wantErr:  false,
+			wantContains: []string{
+				"// Code generated by",
+				"package test",
+				`import "testing"`,
+				"func TestTestStruct_Field(t *testing.T)",
+				`target.SetField("genprop")`,
+			},
+		},
+		{
+			name:     "failure: non-existent file",
+			fileName: "non_existent_file.go",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buffer bytes.Buffer
+
+			err := generateTests(&buffer, tt.fileName, config.Default())
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			output := buffer.String()
+
+			for _, want := range tt.wantContains {
+				assert.Contains(t, output, want)
+			}
+		})
+	}
+}
+
+func TestTestFileNameOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		fileName string
+		want     string
+	}{
+		{
+			name:     "success: go file",
+			fileName: "model/user.go",
+			want:     "model/user_prop_test.go",
+		},
+		{
+			name:     "success: file without extension",
+			fileName: "user",
+			want:     "user_prop_test.go",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, testFileNameOf(tt.fileName))
+		})
+	}
+}
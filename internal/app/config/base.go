@@ -0,0 +1,3 @@
+// Package config provides functionality for loading project-wide settings from genprop.json.
+// This package discovers the configuration file by walking up from the input file and merges it with command line flags.
+package config
@@ -0,0 +1,205 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// FileName is the name of the configuration file.
+const FileName = "genprop.json"
+
+// Settings holds the settings of a configuration file or of command line flags. Nil fields are not set.
+type Settings struct {
+	Tag            *string `json:"tag,omitempty"`
+	Initialism     *string `json:"initialism,omitempty"`
+	ValidationFunc *string `json:"validationFunc,omitempty"`
+	ValidationTag  *string `json:"validationTag,omitempty"`
+	NilSafe        *bool   `json:"nilSafe,omitempty"`
+	Receiver       *string `json:"receiver,omitempty"`
+}
+
+// File is the content of a configuration file.
+// Packages holds overrides keyed by the package directory relative to the configuration file.
+type File struct {
+	Settings
+	Packages map[string]Settings `json:"packages,omitempty"`
+}
+
+// Config holds the effective settings of the generator.
+type Config struct {
+	File           string `json:"file,omitempty"`
+	Tag            string `json:"tag"`
+	Initialism     string `json:"initialism"`
+	ValidationFunc string `json:"validationFunc"`
+	ValidationTag  string `json:"validationTag"`
+	NilSafe        bool   `json:"nilSafe"`
+	Receiver       string `json:"receiver"`
+}
+
+// Default returns the built-in settings.
+func Default() Config {
+	return Config{
+		Tag:            "property",
+		Initialism:     "id,url,api",
+		ValidationFunc: "validateFieldValue",
+		ValidationTag:  "validate",
+	}
+}
+
+// Apply overwrites the config with the settings that are set.
+func (c *Config) Apply(settings Settings) {
+	if settings.Tag != nil {
+		c.Tag = *settings.Tag
+	}
+
+	if settings.Initialism != nil {
+		c.Initialism = *settings.Initialism
+	}
+
+	if settings.ValidationFunc != nil {
+		c.ValidationFunc = *settings.ValidationFunc
+	}
+
+	if settings.ValidationTag != nil {
+		c.ValidationTag = *settings.ValidationTag
+	}
+
+	if settings.NilSafe != nil {
+		c.NilSafe = *settings.NilSafe
+	}
+
+	if settings.Receiver != nil {
+		c.Receiver = *settings.Receiver
+	}
+}
+
+// Find returns the path of the configuration file in dir or its nearest parent directory.
+// It returns an empty string when no configuration file is found.
+func Find(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	for {
+		fileName := filepath.Join(dir, FileName)
+
+		_, err := os.Stat(fileName)
+		if err == nil {
+			return fileName, nil
+		}
+
+		if !os.IsNotExist(err) {
+			return "", errors.WithStack(err)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+
+		dir = parent
+	}
+}
+
+// Load returns the default config merged with the configuration file discovered for the input file.
+// Overrides of the packages that contain the input file are applied from the outermost one.
+func Load(inputFileName string) (Config, error) {
+	config := Default()
+
+	inputDir, err := filepath.Abs(filepath.Dir(inputFileName))
+	if err != nil {
+		return Config{}, errors.WithStack(err)
+	}
+
+	fileName, err := Find(inputDir)
+	if err != nil {
+		return Config{}, err
+	}
+
+	if fileName == "" {
+		return config, nil
+	}
+
+	file, err := readFile(fileName)
+	if err != nil {
+		return Config{}, err
+	}
+
+	config.File = fileName
+	config.Apply(file.Settings)
+
+	for _, settings := range packageSettingsOf(file, filepath.Dir(fileName), inputDir) {
+		config.Apply(settings)
+	}
+
+	return config, nil
+}
+
+func readFile(fileName string) (*File, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var file File
+
+	err = decoder.Decode(&file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", fileName)
+	}
+
+	return &file, nil
+}
+
+// packageSettingsOf returns the overrides of the packages that contain inputDir, ordered from the outermost one.
+// Overrides of the same directory written in different ways, e.g. "model" and "./model", are ordered by key.
+func packageSettingsOf(file *File, configDir string, inputDir string) []Settings {
+	rel, err := filepath.Rel(configDir, inputDir)
+	if err != nil {
+		return nil
+	}
+
+	rel = filepath.ToSlash(rel)
+
+	var dirs []string
+
+	for _, dir := range slices.Sorted(maps.Keys(file.Packages)) {
+		cleaned := filepath.ToSlash(filepath.Clean(dir))
+
+		if cleaned == "." || cleaned == rel || strings.HasPrefix(rel, cleaned+"/") {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	slices.SortStableFunc(dirs, func(a, b string) int {
+		return depthOf(a) - depthOf(b)
+	})
+
+	settings := make([]Settings, 0, len(dirs))
+
+	for _, dir := range dirs {
+		settings = append(settings, file.Packages[dir])
+	}
+
+	return settings
+}
+
+func depthOf(dir string) int {
+	cleaned := filepath.ToSlash(filepath.Clean(dir))
+	if cleaned == "." {
+		return 0
+	}
+
+	return strings.Count(cleaned, "/") + 1
+}
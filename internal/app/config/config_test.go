@@ -0,0 +1,209 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		dir  string
+		want string
+	}{
+		{
+			name: "success: config file in the directory",
+			dir:  "./testdata/project",
+			want: "./testdata/project/genprop.json",
+		},
+		{
+			name: "success: config file in a parent directory",
+			dir:  "./testdata/project/api/v1",
+			want: "./testdata/project/genprop.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Find(tt.dir)
+			require.NoError(t, err)
+
+			want, err := filepath.Abs(tt.want)
+			require.NoError(t, err)
+
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputFileName string
+		want          Config
+		wantErr       bool
+	}{
+		{
+			name:          "success: defaults without config file",
+			inputFileName: "./testdata/input.go.txt",
+			want:          Default(),
+		},
+		{
+			name:          "success: root settings and root package override",
+			inputFileName: "./testdata/project/model/input.go.txt",
+			want: Config{
+				File:           "./testdata/project/genprop.json",
+				Tag:            "property",
+				Initialism:     "id,api,uuid",
+				ValidationFunc: "validateFieldValue",
+				ValidationTag:  "check",
+			},
+		},
+		{
+			name:          "success: nested package overrides are applied from the outermost one",
+			inputFileName: "./testdata/project/api/v1/input.go.txt",
+			want: Config{
+				File:           "./testdata/project/genprop.json",
+				Tag:            "property,prop",
+				Initialism:     "id,api,uuid",
+				ValidationFunc: "validateAPI",
+				ValidationTag:  "check",
+				Receiver:       "v1",
+			},
+		},
+		{
+			name:          "failure: invalid config file",
+			inputFileName: "./testdata/invalid/input.go.txt",
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Load(tt.inputFileName)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			if tt.want.File != "" {
+				tt.want.File, err = filepath.Abs(tt.want.File)
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConfig_Apply(t *testing.T) {
+	t.Parallel()
+
+	initialism := "id"
+	nilSafe := true
+
+	tests := []struct {
+		name     string
+		settings Settings
+		want     Config
+	}{
+		{
+			name:     "success: empty settings keep the config",
+			settings: Settings{},
+			want:     Default(),
+		},
+		{
+			name: "success: set settings overwrite the config",
+			settings: Settings{
+				Initialism: &initialism,
+				NilSafe:    &nilSafe,
+			},
+			want: Config{
+				Tag:            "property",
+				Initialism:     "id",
+				ValidationFunc: "validateFieldValue",
+				ValidationTag:  "validate",
+				NilSafe:        true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Default()
+			got.Apply(tt.settings)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPackageSettingsOf(t *testing.T) {
+	t.Parallel()
+
+	receiverOf := func(name string) Settings {
+		return Settings{Receiver: &name}
+	}
+
+	tests := []struct {
+		name     string
+		packages map[string]Settings
+		inputDir string
+		want     []string
+	}{
+		{
+			name: "success: ordered from the outermost package",
+			packages: map[string]Settings{
+				"api/v1": receiverOf("v1"),
+				".":      receiverOf("root"),
+				"api":    receiverOf("api"),
+				"model":  receiverOf("model"),
+			},
+			inputDir: "api/v1",
+			want:     []string{"root", "api", "v1"},
+		},
+		{
+			name: "success: same directory ordered by key",
+			packages: map[string]Settings{
+				"model/":  receiverOf("slash"),
+				"model":   receiverOf("plain"),
+				"./model": receiverOf("dot"),
+			},
+			inputDir: "model",
+			want:     []string{"dot", "plain", "slash"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for range 10 {
+				settings := packageSettingsOf(&File{Packages: tt.packages}, ".", tt.inputDir)
+
+				var got []string
+				for _, s := range settings {
+					got = append(got, *s.Receiver)
+				}
+
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
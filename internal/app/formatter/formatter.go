@@ -18,15 +18,20 @@ const doNotEdit = "// Code generated by github.com/hidori/go-genprop/cmd/genprop
 func WriteOutput(writer io.Writer, packageName string, decls []ast.Decl) error {
 	buffer := bytes.NewBuffer([]byte{})
 
-	err := format.Node(buffer, token.NewFileSet(), &ast.File{
-		Name:  ast.NewIdent(packageName),
-		Decls: decls,
-	})
-	if err != nil {
-		return errors.WithStack(err)
+	_, _ = fmt.Fprintf(buffer, "package %s\n", packageName)
+
+	for _, decl := range decls {
+		_, _ = fmt.Fprintln(buffer)
+
+		err := writeDecl(buffer, decl)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		_, _ = fmt.Fprintln(buffer)
 	}
 
-	cooked, err := imports.Process("", buffer.Bytes(), &imports.Options{FormatOnly: false})
+	cooked, err := imports.Process("", buffer.Bytes(), &imports.Options{Comments: true, TabIndent: true, TabWidth: 8})
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -36,3 +41,21 @@ func WriteOutput(writer io.Writer, packageName string, decls []ast.Decl) error {
 
 	return nil
 }
+
+// writeDecl formats a declaration preceded by its doc comment.
+// Generated nodes have no positions, so the doc comment is written by hand instead of by go/format.
+func writeDecl(writer io.Writer, decl ast.Decl) error {
+	funcDecl, ok := decl.(*ast.FuncDecl)
+	if !ok || funcDecl.Doc == nil {
+		return format.Node(writer, token.NewFileSet(), decl)
+	}
+
+	for _, comment := range funcDecl.Doc.List {
+		_, _ = fmt.Fprintln(writer, comment.Text)
+	}
+
+	undocumented := *funcDecl
+	undocumented.Doc = nil
+
+	return format.Node(writer, token.NewFileSet(), &undocumented)
+}
@@ -56,6 +56,32 @@ func TestWriteOutput(t *testing.T) {
 				// Basic assertions only
 			},
 		},
+		{
+			name:        "function with doc comment",
+			packageName: "test",
+			decls: []ast.Decl{
+				&ast.FuncDecl{
+					Doc: &ast.CommentGroup{
+						List: []*ast.Comment{
+							{Text: "// TestFunc does nothing."},
+							{Text: "//"},
+							{Text: "// Deprecated: do not use."},
+						},
+					},
+					Name: astutil.NewIdent("TestFunc"),
+					Type: astutil.NewFuncType(nil, nil, nil),
+					Body: astutil.NewBlockStmt([]ast.Stmt{
+						astutil.NewReturnStmt(nil),
+					}),
+				},
+			},
+			wantContains: []string{
+				"// TestFunc does nothing.\n//\n// Deprecated: do not use.\nfunc TestFunc() {",
+			},
+			assert: func(t *testing.T, output string) {
+				// Basic assertions only
+			},
+		},
 		{
 			name:        "function with fmt import",
 			packageName: "withimports",
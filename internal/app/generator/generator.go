@@ -1,26 +1,27 @@
 package generator
 
 import (
+	"fmt"
 	"go/ast"
 	"go/token"
+	"os"
 	"strings"
 
+	"github.com/hidori/go-genprop/internal/app/config"
 	"github.com/hidori/go-genprop/public/generator"
 	"github.com/pkg/errors"
 )
 
-const (
-	tagName = "property"
-)
+var errInvalidTag = errors.New("invalid tag")
 
 // GenerateCode generates AST declarations for getter and setter methods based on the given file and configuration.
-func GenerateCode(file *ast.File, initialismFlag, validationFuncFlag, validationTagFlag string) ([]ast.Decl, error) {
-	generator := generator.NewGenerator(&generator.GeneratorConfig{
-		TagName:        tagName,
-		Initialism:     strings.Split(initialismFlag, ","),
-		ValidationFunc: validationFuncFlag,
-		ValidationTag:  validationTagFlag,
-	})
+// The other files of the package are read for the receiver names of existing methods.
+// Warnings are written to the standard error.
+func GenerateCode(file *ast.File, packageFiles []*ast.File, cfg config.Config) ([]ast.Decl, error) {
+	generator, err := newGenerator(cfg, packageFiles, warn)
+	if err != nil {
+		return nil, err
+	}
 
 	decls, err := generator.Generate(token.NewFileSet(), file)
 	if err != nil {
@@ -29,3 +30,60 @@ func GenerateCode(file *ast.File, initialismFlag, validationFuncFlag, validation
 
 	return decls, nil
 }
+
+// GenerateFakes generates AST declarations for fakes of the interfaces generated from the given file.
+// The other files of the package are read for the receiver names of existing methods.
+func GenerateFakes(file *ast.File, packageFiles []*ast.File, cfg config.Config) ([]ast.Decl, error) {
+	generator, err := newGenerator(cfg, packageFiles, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	decls, err := generator.GenerateFakes(token.NewFileSet(), file)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return decls, nil
+}
+
+// GenerateTests generates AST declarations for unit tests of the getter and setter methods generated from the given file.
+func GenerateTests(file *ast.File, cfg config.Config) ([]ast.Decl, error) {
+	generator, err := newGenerator(cfg, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	decls, err := generator.GenerateTests(token.NewFileSet(), file)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return decls, nil
+}
+
+func newGenerator(cfg config.Config, packageFiles []*ast.File, warn func(message string)) (*generator.Generator, error) {
+	tagNames := strings.Split(cfg.Tag, ",")
+
+	for _, name := range tagNames {
+		if name == "" || strings.ContainsAny(name, " :\"") {
+			return nil, errors.Wrapf(errInvalidTag, "tag=%s", cfg.Tag)
+		}
+	}
+
+	return generator.NewGenerator(&generator.GeneratorConfig{
+		TagName:          tagNames[0],
+		FallbackTagNames: tagNames[1:],
+		Initialism:       strings.Split(cfg.Initialism, ","),
+		ValidationFunc:   cfg.ValidationFunc,
+		ValidationTag:    cfg.ValidationTag,
+		NilSafe:          cfg.NilSafe,
+		Receiver:         cfg.Receiver,
+		PackageFiles:     packageFiles,
+		Warn:             warn,
+	}), nil
+}
+
+func warn(message string) {
+	_, _ = fmt.Fprintf(os.Stderr, "genprop: warning: %s\n", message)
+}
@@ -4,6 +4,7 @@ import (
 	"go/ast"
 	"testing"
 
+	"github.com/hidori/go-genprop/internal/app/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -11,6 +12,130 @@ import (
 func TestGenerateCode(t *testing.T) {
 	t.Parallel()
 
+	tests := []struct {
+		name     string
+		file     *ast.File
+		tag      string
+		nilSafe  bool
+		receiver string
+		wantErr  bool
+	}{
+		{
+			name: "success: calls internal generator",
+			file: &ast.File{
+				Name:  ast.NewIdent("test"),
+				Decls: []ast.Decl{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "success: calls internal generator with nil-safe getters",
+			file: &ast.File{
+				Name:  ast.NewIdent("test"),
+				Decls: []ast.Decl{},
+			},
+			nilSafe: true,
+			wantErr: false,
+		},
+		{
+			name: "failure: invalid receiver name",
+			file: &ast.File{
+				Name:  ast.NewIdent("test"),
+				Decls: []ast.Decl{},
+			},
+			receiver: "json",
+			wantErr:  true,
+		},
+		{
+			name: "success: calls internal generator with multiple tags",
+			file: &ast.File{
+				Name:  ast.NewIdent("test"),
+				Decls: []ast.Decl{},
+			},
+			tag:     "property,prop",
+			wantErr: false,
+		},
+		{
+			name: "failure: empty tag name",
+			file: &ast.File{
+				Name:  ast.NewIdent("test"),
+				Decls: []ast.Decl{},
+			},
+			tag:     "property,",
+			wantErr: true,
+		},
+		{
+			name: "failure: tag name with quote",
+			file: &ast.File{
+				Name:  ast.NewIdent("test"),
+				Decls: []ast.Decl{},
+			},
+			tag:     `prop"erty`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := config.Default()
+			cfg.NilSafe = tt.nilSafe
+			cfg.Receiver = tt.receiver
+
+			if tt.tag != "" {
+				cfg.Tag = tt.tag
+			}
+
+			decls, err := GenerateCode(tt.file, nil, cfg)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, decls)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenerateFakes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		file    *ast.File
+		wantErr bool
+	}{
+		{
+			name: "success: calls internal generator",
+			file: &ast.File{
+				Name:  ast.NewIdent("test"),
+				Decls: []ast.Decl{},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decls, err := GenerateFakes(tt.file, nil, config.Default())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, decls)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenerateTests(t *testing.T) {
+	t.Parallel()
+
 	tests := []struct {
 		name    string
 		file    *ast.File
@@ -30,7 +155,7 @@ func TestGenerateCode(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			decls, err := GenerateCode(tt.file, "id,url,api", "validateFieldValue", "validate")
+			decls, err := GenerateTests(tt.file, config.Default())
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -4,16 +4,45 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 )
 
 // ParseFile parses a Go source file and returns the AST.
 func ParseFile(fileName string) (*ast.File, error) {
-	file, err := parser.ParseFile(token.NewFileSet(), fileName, nil, parser.AllErrors)
+	file, err := parser.ParseFile(token.NewFileSet(), fileName, nil, parser.AllErrors|parser.ParseComments)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
 	return file, nil
 }
+
+// ParsePackageFiles parses the other Go source files of the package in the directory of the given file.
+// Test files, files of other packages and files that can not be parsed, such as an output file truncated by
+// the shell redirection, are skipped.
+func ParsePackageFiles(fileName string, packageName string) ([]*ast.File, error) {
+	fileNames, err := filepath.Glob(filepath.Join(filepath.Dir(fileName), "*.go"))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var files []*ast.File
+
+	for _, name := range fileNames {
+		if strings.HasSuffix(name, "_test.go") || filepath.Clean(name) == filepath.Clean(fileName) {
+			continue
+		}
+
+		file, err := ParseFile(name)
+		if err != nil || file.Name.Name != packageName {
+			continue
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
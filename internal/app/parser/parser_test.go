@@ -72,3 +72,57 @@ func TestParseFile(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePackageFiles(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		fileName    string
+		packageName string
+		wantLen     int
+		wantError   bool
+	}{
+		{
+			name:        "success: skips the file, test files and other packages",
+			fileName:    "../testdata/package/user.go",
+			packageName: "data",
+			wantLen:     1,
+		},
+		{
+			name:        "success: no files of the package",
+			fileName:    "../testdata/package/user.go",
+			packageName: "other",
+			wantLen:     0,
+		},
+		{
+			name:        "success: skips files with invalid syntax",
+			fileName:    "../testdata/package_invalid/user.go",
+			packageName: "data",
+			wantLen:     0,
+		},
+		{
+			name:        "failure: malformed directory name",
+			fileName:    "../testdata/[/user.go",
+			packageName: "data",
+			wantError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			files, err := ParsePackageFiles(tt.fileName, tt.packageName)
+
+			if tt.wantError {
+				assert.Error(t, err)
+				assert.Nil(t, files)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, files, tt.wantLen)
+		})
+	}
+}
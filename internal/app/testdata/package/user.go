@@ -0,0 +1,5 @@
+package data
+
+type User struct {
+	name string `property:"get,set"`
+}
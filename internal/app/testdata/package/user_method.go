@@ -0,0 +1,5 @@
+package data
+
+func (u *User) String() string {
+	return u.name
+}
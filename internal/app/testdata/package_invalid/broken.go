@@ -0,0 +1,3 @@
+package data
+
+func (u *User {
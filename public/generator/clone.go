@@ -0,0 +1,233 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"github.com/hidori/go-astutil"
+	"github.com/hidori/go-typeutil"
+)
+
+func (g *Generator) cloneFuncDecl(structName string, fieldList *ast.FieldList) ast.Decl {
+	stmts := []ast.Stmt{
+		&ast.IfStmt{
+			Cond: &ast.BinaryExpr{Op: token.EQL, X: g.recvIdent(), Y: astutil.NewIdent("nil")},
+			Body: astutil.NewBlockStmt(
+				[]ast.Stmt{
+					astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("nil")}),
+				},
+			),
+		},
+		astutil.NewAssignStmt(
+			[]ast.Expr{astutil.NewIdent("c")},
+			token.DEFINE,
+			[]ast.Expr{
+				&ast.UnaryExpr{
+					Op: token.AND,
+					X:  &ast.CompositeLit{Type: astutil.NewIdent(structName)},
+				},
+			},
+		),
+	}
+
+	for _, field := range fieldList.List {
+		if isSyncType(field.Type) {
+			continue
+		}
+
+		for _, name := range fieldNamesOf(field) {
+			stmts = append(stmts, g.buildCloneFieldStmt(name, field.Type))
+		}
+	}
+
+	stmts = append(stmts, astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("c")}))
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("Clone"),
+		Type: astutil.NewFuncType(
+			nil,
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(nil, astutil.NewStarExpr(astutil.NewIdent(structName))),
+				},
+			),
+		),
+		Body: astutil.NewBlockStmt(stmts),
+	}
+}
+
+func (g *Generator) buildCloneFieldStmt(name string, fieldType ast.Expr) ast.Stmt {
+	return g.buildCloneStmt(
+		astutil.NewSelectorExpr(astutil.NewIdent("c"), astutil.NewIdent(name)),
+		astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(name)),
+		fieldType,
+		0,
+	)
+}
+
+// buildCloneStmt copies src of the given type into dst.
+// Elements of slices and maps and pointees are copied deeply when they are slices, maps, pointers or types with Clone(),
+// and the locals of nested loops are numbered by depth, e.g. i, i1, i2.
+func (g *Generator) buildCloneStmt(dst ast.Expr, src ast.Expr, fieldType ast.Expr, depth int) ast.Stmt {
+	assign := func(value ast.Expr) ast.Stmt {
+		return astutil.NewAssignStmt([]ast.Expr{dst}, token.ASSIGN, []ast.Expr{value})
+	}
+
+	call := func(fun ast.Expr, args ...ast.Expr) ast.Expr {
+		return &ast.CallExpr{Fun: fun, Args: args}
+	}
+
+	ifNotNil := func(stmts ...ast.Stmt) ast.Stmt {
+		return &ast.IfStmt{
+			Cond: &ast.BinaryExpr{Op: token.NEQ, X: src, Y: astutil.NewIdent("nil")},
+			Body: astutil.NewBlockStmt(stmts),
+		}
+	}
+
+	switch t := fieldType.(type) {
+	case *ast.ArrayType:
+		if t.Len != nil {
+			break
+		}
+
+		if !g.isDeepCopyType(t.Elt) {
+			return assign(call(astutil.NewSelectorExpr(astutil.NewIdent("slices"), astutil.NewIdent("Clone")), src))
+		}
+
+		index := astutil.NewIdent(localNameOf("i", depth))
+		value := astutil.NewIdent(localNameOf("v", depth))
+
+		return ifNotNil(
+			assign(call(astutil.NewIdent("make"), fieldType, call(astutil.NewIdent("len"), src))),
+			&ast.RangeStmt{
+				Key:   index,
+				Value: value,
+				Tok:   token.DEFINE,
+				X:     src,
+				Body: astutil.NewBlockStmt(
+					[]ast.Stmt{
+						g.buildCloneStmt(&ast.IndexExpr{X: operandOf(dst), Index: index}, value, t.Elt, depth+1),
+					},
+				),
+			},
+		)
+
+	case *ast.MapType:
+		if !g.isDeepCopyType(t.Value) {
+			return assign(call(astutil.NewSelectorExpr(astutil.NewIdent("maps"), astutil.NewIdent("Clone")), src))
+		}
+
+		key := astutil.NewIdent(localNameOf("k", depth))
+		value := astutil.NewIdent(localNameOf("v", depth))
+
+		return ifNotNil(
+			assign(call(astutil.NewIdent("make"), fieldType, call(astutil.NewIdent("len"), src))),
+			&ast.RangeStmt{
+				Key:   key,
+				Value: value,
+				Tok:   token.DEFINE,
+				X:     src,
+				Body: astutil.NewBlockStmt(
+					[]ast.Stmt{
+						g.buildCloneStmt(&ast.IndexExpr{X: operandOf(dst), Index: key}, value, t.Value, depth+1),
+					},
+				),
+			},
+		)
+
+	case *ast.Ident:
+		if g.cloneTypes[t.Name] {
+			return assign(astutil.NewStarExpr(call(astutil.NewSelectorExpr(operandOf(src), astutil.NewIdent("Clone")))))
+		}
+
+	case *ast.StarExpr:
+		if ident := typeutil.AsOrEmpty[*ast.Ident](t.X); ident != nil && g.cloneTypes[ident.Name] {
+			return assign(call(astutil.NewSelectorExpr(operandOf(src), astutil.NewIdent("Clone"))))
+		}
+
+		// Locks must not be shared with the clone, so the clone gets a new one.
+		if isSyncType(t.X) {
+			return ifNotNil(assign(call(astutil.NewIdent("new"), t.X)))
+		}
+
+		// Other pointees are copied into a new value in the same way as the fields, e.g. for **T or *[]T.
+		return ifNotNil(
+			assign(call(astutil.NewIdent("new"), t.X)),
+			g.buildCloneStmt(astutil.NewStarExpr(dst), astutil.NewStarExpr(src), t.X, depth),
+		)
+	}
+
+	return assign(src)
+}
+
+// operandOf parenthesizes dereferences, so that they can be indexed or selected, e.g. (*c.x)[i].
+func operandOf(x ast.Expr) ast.Expr {
+	if _, ok := x.(*ast.StarExpr); ok {
+		return &ast.ParenExpr{X: x}
+	}
+
+	return x
+}
+
+// isDeepCopyType reports whether values of the type share memory when they are assigned.
+func (g *Generator) isDeepCopyType(fieldType ast.Expr) bool {
+	switch t := fieldType.(type) {
+	case *ast.ArrayType:
+		return t.Len == nil
+
+	case *ast.MapType, *ast.StarExpr:
+		return true
+
+	case *ast.Ident:
+		return g.cloneTypes[t.Name]
+
+	default:
+		return false
+	}
+}
+
+func localNameOf(name string, depth int) string {
+	if depth == 0 {
+		return name
+	}
+
+	return name + strconv.Itoa(depth)
+}
+
+func fieldNamesOf(field *ast.Field) []string {
+	var names []string
+
+	for _, name := range field.Names {
+		names = append(names, name.Name)
+	}
+
+	if len(names) > 0 {
+		return names
+	}
+
+	fieldType := field.Type
+
+	if starExpr := typeutil.AsOrEmpty[*ast.StarExpr](fieldType); starExpr != nil {
+		fieldType = starExpr.X
+	}
+
+	switch t := fieldType.(type) {
+	case *ast.Ident:
+		return []string{t.Name}
+
+	case *ast.SelectorExpr:
+		return []string{t.Sel.Name}
+
+	default:
+		return nil
+	}
+}
+
+func isSyncType(fieldType ast.Expr) bool {
+	selectorExpr := typeutil.AsOrEmpty[*ast.SelectorExpr](fieldType)
+
+	return selectorExpr != nil && isIdentType(selectorExpr.X, "sync")
+}
@@ -0,0 +1,146 @@
+package generator
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCloneFieldStmt(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+	generator.cloneTypes = map[string]bool{"Address": true}
+
+	tests := []struct {
+		name      string
+		fieldType ast.Expr
+		want      string
+	}{
+		{
+			name:      "success: value",
+			fieldType: &ast.Ident{Name: "int"},
+			want:      "c.value = t.value",
+		},
+		{
+			name:      "success: slice",
+			fieldType: &ast.ArrayType{Elt: &ast.Ident{Name: "string"}},
+			want:      "c.value = slices.Clone(t.value)",
+		},
+		{
+			name:      "success: array",
+			fieldType: &ast.ArrayType{Len: &ast.BasicLit{Kind: token.INT, Value: "4"}, Elt: &ast.Ident{Name: "byte"}},
+			want:      "c.value = t.value",
+		},
+		{
+			name:      "success: map",
+			fieldType: &ast.MapType{Key: &ast.Ident{Name: "string"}, Value: &ast.Ident{Name: "int"}},
+			want:      "c.value = maps.Clone(t.value)",
+		},
+		{
+			name:      "success: type with clone directive",
+			fieldType: &ast.Ident{Name: "Address"},
+			want:      "c.value = *t.value.Clone()",
+		},
+		{
+			name:      "success: pointer to type with clone directive",
+			fieldType: &ast.StarExpr{X: &ast.Ident{Name: "Address"}},
+			want:      "c.value = t.value.Clone()",
+		},
+		{
+			name:      "success: pointer to other type",
+			fieldType: &ast.StarExpr{X: &ast.Ident{Name: "string"}},
+			want:      "if t.value != nil {\n\tc.value = new(string)\n\t*c.value = *t.value\n}",
+		},
+		{
+			name:      "success: pointer to lock",
+			fieldType: &ast.StarExpr{X: &ast.SelectorExpr{X: &ast.Ident{Name: "sync"}, Sel: &ast.Ident{Name: "Mutex"}}},
+			want:      "if t.value != nil {\n\tc.value = new(sync.Mutex)\n}",
+		},
+		{
+			name:      "success: slice of pointers to type with clone directive",
+			fieldType: &ast.ArrayType{Elt: &ast.StarExpr{X: &ast.Ident{Name: "Address"}}},
+			want:      "if t.value != nil {\n\tc.value = make([]*Address, len(t.value))\n\tfor i, v := range t.value {\n\t\tc.value[i] = v.Clone()\n\t}\n}",
+		},
+		{
+			name:      "success: nested slice",
+			fieldType: &ast.ArrayType{Elt: &ast.ArrayType{Elt: &ast.Ident{Name: "int"}}},
+			want:      "if t.value != nil {\n\tc.value = make([][]int, len(t.value))\n\tfor i, v := range t.value {\n\t\tc.value[i] = slices.Clone(v)\n\t}\n}",
+		},
+		{
+			name:      "success: map of pointers",
+			fieldType: &ast.MapType{Key: &ast.Ident{Name: "string"}, Value: &ast.StarExpr{X: &ast.Ident{Name: "int"}}},
+			want:      "if t.value != nil {\n\tc.value = make(map[string]*int, len(t.value))\n\tfor k, v := range t.value {\n\t\tif v != nil {\n\t\t\tc.value[k] = new(int)\n\t\t\t*c.value[k] = *v\n\t\t}\n\t}\n}",
+		},
+		{
+			name:      "success: pointer to slice",
+			fieldType: &ast.StarExpr{X: &ast.ArrayType{Elt: &ast.Ident{Name: "string"}}},
+			want:      "if t.value != nil {\n\tc.value = new([]string)\n\t*c.value = slices.Clone(*t.value)\n}",
+		},
+		{
+			name:      "success: pointer to pointer",
+			fieldType: &ast.StarExpr{X: &ast.StarExpr{X: &ast.Ident{Name: "int"}}},
+			want:      "if t.value != nil {\n\tc.value = new(*int)\n\tif *t.value != nil {\n\t\t*c.value = new(int)\n\t\t**c.value = **t.value\n\t}\n}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := generator.buildCloneFieldStmt("value", tt.fieldType)
+
+			buffer := bytes.NewBuffer([]byte{})
+			require.NoError(t, format.Node(buffer, token.NewFileSet(), got))
+			assert.Equal(t, tt.want, buffer.String())
+		})
+	}
+}
+
+func TestFieldNamesOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		field *ast.Field
+		want  []string
+	}{
+		{
+			name: "success: named fields",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "x"}, {Name: "y"}},
+				Type:  &ast.Ident{Name: "int"},
+			},
+			want: []string{"x", "y"},
+		},
+		{
+			name: "success: embedded type",
+			field: &ast.Field{
+				Type: &ast.Ident{Name: "Base"},
+			},
+			want: []string{"Base"},
+		},
+		{
+			name: "success: embedded pointer to qualified type",
+			field: &ast.Field{
+				Type: &ast.StarExpr{X: &ast.SelectorExpr{X: &ast.Ident{Name: "ast"}, Sel: &ast.Ident{Name: "File"}}},
+			},
+			want: []string{"File"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, fieldNamesOf(tt.field))
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/hidori/go-astutil"
+)
+
+func (g *Generator) propertyFields(fieldList *ast.FieldList, filter func(field *ast.Field) bool) []*ast.Field {
+	var fields []*ast.Field
+
+	for _, field := range fieldList.List {
+		if g.isPropertyField(field) && (filter == nil || filter(field)) {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+func (g *Generator) setterNameOf(field *ast.Field) (string, bool) {
+	switch {
+	case g.hasDirective(field, "set"):
+		return "Set" + g.prepareFieldName(field.Names[0].Name), true
+
+	case g.hasDirective(field, "set=private"):
+		return "set" + g.prepareFieldName(field.Names[0].Name), true
+
+	default:
+		return "", false
+	}
+}
+
+func (g *Generator) hasValidation(field *ast.Field) bool {
+	return len(structTag(field).Get(g.config.ValidationTag)) > 0
+}
+
+func (g *Generator) buildCodecStructType(fields []*ast.Field, tagFunc func(field *ast.Field) string) *ast.StructType {
+	var list []*ast.Field
+
+	for _, field := range fields {
+		codecField := astutil.NewField(
+			[]*ast.Ident{
+				astutil.NewIdent(g.prepareFieldName(field.Names[0].Name)),
+			},
+			field.Type,
+		)
+
+		if tagFunc != nil {
+			codecField.Tag = astutil.NewBasicLit(token.STRING, tagFunc(field))
+		}
+
+		list = append(list, codecField)
+	}
+
+	return &ast.StructType{
+		Fields: astutil.NewFieldList(list),
+	}
+}
+
+func (g *Generator) buildCodecVarStmt(codecType *ast.StructType) ast.Stmt {
+	return &ast.DeclStmt{
+		Decl: &ast.GenDecl{
+			Tok: token.VAR,
+			Specs: []ast.Spec{
+				&ast.ValueSpec{
+					Names: []*ast.Ident{
+						astutil.NewIdent("v"),
+					},
+					Type: codecType,
+				},
+			},
+		},
+	}
+}
+
+func (g *Generator) buildStoreStmts(fields []*ast.Field, dst ast.Expr) []ast.Stmt {
+	var stmts []ast.Stmt
+
+	for _, field := range fields {
+		stmts = append(stmts, astutil.NewAssignStmt(
+			[]ast.Expr{
+				astutil.NewSelectorExpr(dst, astutil.NewIdent(g.prepareFieldName(field.Names[0].Name))),
+			},
+			token.ASSIGN,
+			[]ast.Expr{
+				astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name)),
+			},
+		))
+	}
+
+	return stmts
+}
+
+func (g *Generator) buildReturnIfErrStmt(callExpr ast.Expr) ast.Stmt {
+	return g.buildIfErrStmt(
+		[]ast.Expr{astutil.NewIdent("err")},
+		callExpr,
+		[]ast.Expr{astutil.NewIdent("err")},
+	)
+}
+
+func (g *Generator) buildIfErrStmt(lhs []ast.Expr, callExpr ast.Expr, results []ast.Expr) ast.Stmt {
+	return &ast.IfStmt{
+		Init: astutil.NewAssignStmt(
+			lhs,
+			token.DEFINE,
+			[]ast.Expr{
+				callExpr,
+			},
+		),
+		Cond: &ast.BinaryExpr{
+			Op: token.NEQ,
+			X:  astutil.NewIdent("err"),
+			Y:  astutil.NewIdent("nil"),
+		},
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				astutil.NewReturnStmt(results),
+			},
+		),
+	}
+}
+
+func (g *Generator) buildRestoreStmts(fields []*ast.Field, src ast.Expr) []ast.Stmt {
+	return g.buildAssignFieldsStmts(fields, func(field *ast.Field) ast.Expr {
+		return astutil.NewSelectorExpr(src, astutil.NewIdent(g.prepareFieldName(field.Names[0].Name)))
+	}, nil)
+}
+
+// buildAssignFieldsStmts assigns the values to the fields, through the generated setters when available.
+// The values of the fields with validation are normalized and validated into locals first, and no field is assigned
+// unless all of them are valid, so that the struct is left unchanged on error.
+// When condOf is not nil, each field is assigned only when the condition returned for it holds.
+func (g *Generator) buildAssignFieldsStmts(
+	fields []*ast.Field, valueOf func(field *ast.Field) ast.Expr, condOf func(field *ast.Field) ast.Expr,
+) []ast.Stmt {
+	var validateStmts, assignStmts []ast.Stmt
+
+	guard := func(field *ast.Field, stmts []ast.Stmt) []ast.Stmt {
+		if condOf == nil {
+			return stmts
+		}
+
+		return []ast.Stmt{
+			&ast.IfStmt{
+				Cond: condOf(field),
+				Body: astutil.NewBlockStmt(stmts),
+			},
+		}
+	}
+
+	for _, field := range fields {
+		if _, ok := g.setterNameOf(field); !ok || !g.hasValidation(field) {
+			assignStmts = append(assignStmts, guard(field, []ast.Stmt{g.buildAssignFieldStmt(field, valueOf(field))})...)
+
+			continue
+		}
+
+		name := field.Names[0].Name + "Value"
+
+		if condOf == nil {
+			validateStmts = append(validateStmts, astutil.NewAssignStmt(
+				[]ast.Expr{astutil.NewIdent(name)},
+				token.DEFINE,
+				[]ast.Expr{valueOf(field)},
+			))
+		} else {
+			validateStmts = append(validateStmts, g.buildVarStmt(name, field.Type))
+		}
+
+		stmts := []ast.Stmt{}
+
+		if condOf != nil {
+			stmts = append(stmts, astutil.NewAssignStmt(
+				[]ast.Expr{astutil.NewIdent(name)},
+				token.ASSIGN,
+				[]ast.Expr{valueOf(field)},
+			))
+		}
+
+		stmts = append(stmts, g.buildBeforeStmts(field, name)...)
+		stmts = append(stmts, &ast.IfStmt{
+			Init: astutil.NewAssignStmt(
+				[]ast.Expr{astutil.NewIdent("err")},
+				token.DEFINE,
+				[]ast.Expr{g.buildValidationCallExpr(field, structTag(field).Get(g.config.ValidationTag), astutil.NewIdent(name))},
+			),
+			Cond: &ast.BinaryExpr{
+				Op: token.NEQ,
+				X:  astutil.NewIdent("err"),
+				Y:  astutil.NewIdent("nil"),
+			},
+			Body: astutil.NewBlockStmt(
+				[]ast.Stmt{
+					astutil.NewAssignStmt(
+						[]ast.Expr{astutil.NewIdent("errs")},
+						token.ASSIGN,
+						[]ast.Expr{
+							&ast.CallExpr{
+								Fun:  astutil.NewIdent("append"),
+								Args: []ast.Expr{astutil.NewIdent("errs"), astutil.NewIdent("err")},
+							},
+						},
+					),
+				},
+			),
+		})
+
+		validateStmts = append(validateStmts, guard(field, stmts)...)
+
+		stmts = []ast.Stmt{
+			astutil.NewAssignStmt(
+				[]ast.Expr{astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name))},
+				token.ASSIGN,
+				[]ast.Expr{astutil.NewIdent(name)},
+			),
+		}
+		stmts = append(stmts, g.buildSetterAfterStmts(field)...)
+
+		assignStmts = append(assignStmts, guard(field, stmts)...)
+	}
+
+	if len(validateStmts) < 1 {
+		return append(assignStmts, astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("nil")}))
+	}
+
+	stmts := []ast.Stmt{g.buildVarStmt("errs", &ast.ArrayType{Elt: astutil.NewIdent("error")})}
+	stmts = append(stmts, validateStmts...)
+	stmts = append(stmts, &ast.IfStmt{
+		Cond: &ast.BinaryExpr{
+			Op: token.GTR,
+			X: &ast.CallExpr{
+				Fun:  astutil.NewIdent("len"),
+				Args: []ast.Expr{astutil.NewIdent("errs")},
+			},
+			Y: astutil.NewBasicLit(token.INT, "0"),
+		},
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				astutil.NewReturnStmt(
+					[]ast.Expr{
+						&ast.CallExpr{
+							Fun:      astutil.NewSelectorExpr(astutil.NewIdent("errors"), astutil.NewIdent("Join")),
+							Args:     []ast.Expr{astutil.NewIdent("errs")},
+							Ellipsis: 1,
+						},
+					},
+				),
+			},
+		),
+	})
+	stmts = append(stmts, assignStmts...)
+
+	return append(stmts, astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("nil")}))
+}
+
+// buildAssignFieldStmt assigns the value to the field through the generated setter when available.
+// Setters with validation are not called, see buildAssignFieldsStmts.
+func (g *Generator) buildAssignFieldStmt(field *ast.Field, valueExpr ast.Expr) ast.Stmt {
+	setterName, ok := g.setterNameOf(field)
+	if !ok || g.hasValidation(field) {
+		return astutil.NewAssignStmt(
+			[]ast.Expr{
+				astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name)),
+			},
+			token.ASSIGN,
+			[]ast.Expr{
+				valueExpr,
+			},
+		)
+	}
+
+	return &ast.ExprStmt{
+		X: &ast.CallExpr{
+			Fun:  astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(setterName)),
+			Args: []ast.Expr{valueExpr},
+		},
+	}
+}
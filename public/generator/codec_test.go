@@ -0,0 +1,143 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildAssignFieldStmt(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName:        tagName,
+		ValidationFunc: "validate",
+		ValidationTag:  "validate",
+	})
+
+	tests := []struct {
+		name     string
+		tag      string
+		wantStmt ast.Stmt
+	}{
+		{
+			name:     "success: no setter assigns field",
+			tag:      "`property:\"get\"`",
+			wantStmt: &ast.AssignStmt{},
+		},
+		{
+			name:     "success: setter without validation calls setter",
+			tag:      "`property:\"get,set\"`",
+			wantStmt: &ast.ExprStmt{},
+		},
+		{
+			name:     "success: setter with validation assigns field",
+			tag:      "`property:\"get,set=private\" validate:\"required\"`",
+			wantStmt: &ast.AssignStmt{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			field := &ast.Field{
+				Names: []*ast.Ident{{Name: "value"}},
+				Type:  &ast.Ident{Name: "string"},
+				Tag:   &ast.BasicLit{Value: tt.tag},
+			}
+
+			stmt := generator.buildAssignFieldStmt(field, &ast.Ident{Name: "x"})
+
+			assert.IsType(t, tt.wantStmt, stmt)
+		})
+	}
+}
+
+func TestBuildAssignFieldsStmts(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName:        tagName,
+		ValidationFunc: "validate",
+		ValidationTag:  "validate",
+	})
+
+	valueOf := func(field *ast.Field) ast.Expr {
+		return &ast.Ident{Name: "x"}
+	}
+
+	condOf := func(field *ast.Field) ast.Expr {
+		return &ast.Ident{Name: "ok"}
+	}
+
+	tests := []struct {
+		name      string
+		tags      []string
+		condOf    func(field *ast.Field) ast.Expr
+		wantStmts []ast.Stmt
+	}{
+		{
+			name: "success: fields without validation are assigned",
+			tags: []string{"`property:\"get\"`", "`property:\"set\"`"},
+			wantStmts: []ast.Stmt{
+				&ast.AssignStmt{},
+				&ast.ExprStmt{},
+				&ast.ReturnStmt{},
+			},
+		},
+		{
+			name: "success: fields with validation are validated before any field is assigned",
+			tags: []string{"`property:\"set\"`", "`property:\"set\" validate:\"required\"`"},
+			wantStmts: []ast.Stmt{
+				&ast.DeclStmt{},
+				&ast.AssignStmt{},
+				&ast.IfStmt{},
+				&ast.IfStmt{},
+				&ast.ExprStmt{},
+				&ast.AssignStmt{},
+				&ast.ReturnStmt{},
+			},
+		},
+		{
+			name:   "success: fields are guarded by conditions",
+			tags:   []string{"`property:\"set\"`", "`property:\"set\" validate:\"required\"`"},
+			condOf: condOf,
+			wantStmts: []ast.Stmt{
+				&ast.DeclStmt{},
+				&ast.DeclStmt{},
+				&ast.IfStmt{},
+				&ast.IfStmt{},
+				&ast.IfStmt{},
+				&ast.IfStmt{},
+				&ast.ReturnStmt{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var fields []*ast.Field
+
+			for _, tag := range tt.tags {
+				fields = append(fields, &ast.Field{
+					Names: []*ast.Ident{{Name: "value"}},
+					Type:  &ast.Ident{Name: "string"},
+					Tag:   &ast.BasicLit{Value: tag},
+				})
+			}
+
+			stmts := generator.buildAssignFieldsStmts(fields, valueOf, tt.condOf)
+
+			require.Len(t, stmts, len(tt.wantStmts))
+
+			for i, stmt := range stmts {
+				assert.IsType(t, tt.wantStmts[i], stmt)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/hidori/go-astutil"
+	"github.com/pkg/errors"
+)
+
+var mutatorSummaries = map[string]string{
+	"Toggle":  "inverts the %s.",
+	"Enable":  "sets the %s to true.",
+	"Disable": "sets the %s to false.",
+	"Inc":     "adds delta to the %s.",
+	"Dec":     "subtracts delta from the %s.",
+}
+
+func (g *Generator) convenienceFuncDecls(directive, structName string, field *ast.Field) ([]ast.Decl, error) {
+	if len(field.Names) == 0 {
+		return []ast.Decl{}, nil
+	}
+
+	selectorExpr := astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name))
+
+	switch {
+	case directive == "is" && isIdentType(field.Type, "bool"):
+		return declsOf(g.getterFuncDecl("Is", structName, field)), nil
+
+	case directive == "toggle" && isIdentType(field.Type, "bool"):
+		return []ast.Decl{
+			g.mutatorFuncDecl("Toggle", structName, field, nil, &ast.UnaryExpr{Op: token.NOT, X: selectorExpr}),
+			g.mutatorFuncDecl("Enable", structName, field, nil, astutil.NewIdent("true")),
+			g.mutatorFuncDecl("Disable", structName, field, nil, astutil.NewIdent("false")),
+		}, nil
+
+	case directive == "inc" && isNumericType(field.Type):
+		return []ast.Decl{
+			g.mutatorFuncDecl("Inc", structName, field, field.Type, g.buildBinaryDeltaExpr(token.ADD, selectorExpr)),
+			g.mutatorFuncDecl("Dec", structName, field, field.Type, g.buildBinaryDeltaExpr(token.SUB, selectorExpr)),
+		}, nil
+
+	default:
+		return nil, errors.Wrapf(errInvalidFieldType, "directive=%s field=%s", directive, field.Names[0].Name)
+	}
+}
+
+func (g *Generator) mutatorFuncDecl(
+	verb string, structName string, field *ast.Field, deltaType ast.Expr, valueExpr ast.Expr,
+) ast.Decl {
+	var params *ast.FieldList
+
+	if deltaType != nil {
+		params = astutil.NewFieldList(
+			[]*ast.Field{
+				astutil.NewField(
+					[]*ast.Ident{
+						astutil.NewIdent("delta"),
+					},
+					deltaType,
+				),
+			},
+		)
+	}
+
+	validationTag := structTag(field).Get(g.config.ValidationTag)
+
+	var results *ast.FieldList
+
+	if len(validationTag) > 0 {
+		results = astutil.NewFieldList(
+			[]*ast.Field{
+				astutil.NewField(nil, astutil.NewIdent("error")),
+			},
+		)
+	}
+
+	name := verb + g.prepareFieldName(field.Names[0].Name)
+
+	return &ast.FuncDecl{
+		Doc:  accessorDoc(name, fmt.Sprintf(mutatorSummaries[verb], field.Names[0].Name), field),
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent(name),
+		Type: astutil.NewFuncType(nil, params, results),
+		Body: g.buildMutatorBody(field, validationTag, valueExpr),
+	}
+}
+
+func (g *Generator) buildMutatorBody(field *ast.Field, validationTag string, valueExpr ast.Expr) *ast.BlockStmt {
+	selectorExpr := astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name))
+
+	if len(validationTag) < 1 && len(g.buildSetterBeforeStmts(field)) < 1 && len(g.buildSetterAfterStmts(field)) < 1 {
+		return astutil.NewBlockStmt(
+			[]ast.Stmt{
+				astutil.NewAssignStmt(
+					[]ast.Expr{
+						selectorExpr,
+					},
+					token.ASSIGN,
+					[]ast.Expr{
+						valueExpr,
+					},
+				),
+			},
+		)
+	}
+
+	stmts := []ast.Stmt{
+		astutil.NewAssignStmt(
+			[]ast.Expr{
+				astutil.NewIdent("v"),
+			},
+			token.DEFINE,
+			[]ast.Expr{
+				valueExpr,
+			},
+		),
+	}
+
+	if len(validationTag) > 0 {
+		return astutil.NewBlockStmt(append(stmts, g.buildValidationBody(field, validationTag).List...))
+	}
+
+	return astutil.NewBlockStmt(append(stmts, g.buildNoValidationBody(field).List...))
+}
+
+func (g *Generator) buildBinaryDeltaExpr(op token.Token, x ast.Expr) ast.Expr {
+	return &ast.BinaryExpr{
+		Op: op,
+		X:  x,
+		Y:  astutil.NewIdent("delta"),
+	}
+}
@@ -0,0 +1,113 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvenienceFuncDecls(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName:        tagName,
+		ValidationFunc: "validate",
+		ValidationTag:  "validate",
+	})
+
+	tests := []struct {
+		name       string
+		directive  string
+		field      *ast.Field
+		wantNames  []string
+		wantResult bool
+		wantErr    bool
+	}{
+		{
+			name:      "success: is directive on bool field",
+			directive: "is",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "active"}},
+				Type:  &ast.Ident{Name: "bool"},
+			},
+			wantNames:  []string{"IsActive"},
+			wantResult: true,
+		},
+		{
+			name:      "success: toggle directive on bool field",
+			directive: "toggle",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "active"}},
+				Type:  &ast.Ident{Name: "bool"},
+			},
+			wantNames: []string{"ToggleActive", "EnableActive", "DisableActive"},
+		},
+		{
+			name:      "success: inc directive on numeric field with validation",
+			directive: "inc",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "count"}},
+				Type:  &ast.Ident{Name: "int"},
+				Tag:   &ast.BasicLit{Value: "`property:\"inc\" validate:\"gte=0\"`"},
+			},
+			wantNames:  []string{"IncCount", "DecCount"},
+			wantResult: true,
+		},
+		{
+			name:      "success: anonymous field returns empty",
+			directive: "is",
+			field: &ast.Field{
+				Names: []*ast.Ident{},
+				Type:  &ast.Ident{Name: "bool"},
+			},
+			wantNames: []string{},
+		},
+		{
+			name:      "failure: is directive on string field",
+			directive: "is",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "name"}},
+				Type:  &ast.Ident{Name: "string"},
+			},
+			wantErr: true,
+		},
+		{
+			name:      "failure: inc directive on bool field",
+			directive: "inc",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "active"}},
+				Type:  &ast.Ident{Name: "bool"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decls, err := generator.convenienceFuncDecls(tt.directive, "TestStruct", tt.field)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errInvalidFieldType)
+				return
+			}
+
+			require.NoError(t, err)
+
+			names := []string{}
+
+			for _, decl := range decls {
+				funcDecl, ok := decl.(*ast.FuncDecl)
+				require.True(t, ok)
+
+				names = append(names, funcDecl.Name.Name)
+				assert.Equal(t, tt.wantResult, funcDecl.Type.Results != nil)
+			}
+
+			assert.Equal(t, tt.wantNames, names)
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package generator
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"time"
+
+	"github.com/hidori/go-astutil"
+	"github.com/hidori/go-typeutil"
+	"github.com/pkg/errors"
+)
+
+const defaultTagName = "default"
+
+var errInvalidDefaultValue = errors.New("invalid default value")
+
+func (g *Generator) checkDefaultTag(field *ast.Field) error {
+	value, ok := structTag(field).Lookup(defaultTagName)
+	if !ok {
+		return nil
+	}
+
+	_, ok = g.directiveValue(field, "lazy")
+	if ok {
+		return errors.Wrapf(errInvalidTagValue, "default can not be combined with lazy: default=%s", value)
+	}
+
+	_, err := g.defaultValueExpr(field.Type, value)
+
+	return err
+}
+
+func (g *Generator) defaultValueExprOf(field *ast.Field) ast.Expr {
+	value, ok := structTag(field).Lookup(defaultTagName)
+	if !ok {
+		return nil
+	}
+
+	expr, err := g.defaultValueExpr(field.Type, value)
+	if err != nil {
+		return nil
+	}
+
+	return expr
+}
+
+func (g *Generator) defaultValueExpr(fieldType ast.Expr, value string) (ast.Expr, error) {
+	if isDurationType(fieldType) {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, errors.Wrapf(errInvalidDefaultValue, "default=%s", value)
+		}
+
+		return durationExpr(fieldType.(*ast.SelectorExpr).X, d), nil
+	}
+
+	ident := typeutil.AsOrEmpty[*ast.Ident](fieldType)
+	if ident == nil {
+		return nil, errors.Wrapf(errInvalidDefaultValue, "unsupported type: default=%s", value)
+	}
+
+	expr, err := g.basicDefaultValueExpr(ident.Name, value)
+	if err != nil {
+		return nil, errors.Wrapf(errInvalidDefaultValue, "type=%s default=%s", ident.Name, value)
+	}
+
+	return expr, nil
+}
+
+func (g *Generator) basicDefaultValueExpr(typeName string, value string) (ast.Expr, error) {
+	if typeName == "string" {
+		return astutil.NewBasicLit(token.STRING, strconv.Quote(value)), nil
+	}
+
+	if typeName == "bool" {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		return astutil.NewIdent(strconv.FormatBool(b)), nil
+	}
+
+	// The value is written to the generated code as it is, so it must be a Go literal as well,
+	// e.g. strconv.ParseFloat accepts NaN, which is not.
+	if !isNumberLiteral(value) {
+		return nil, errors.Errorf("not a number literal: %s", value)
+	}
+
+	if bitSize, ok := intBitSizes[typeName]; ok {
+		_, err := strconv.ParseInt(value, 0, bitSize)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		return astutil.NewBasicLit(token.INT, value), nil
+	}
+
+	if bitSize, ok := uintBitSizes[typeName]; ok {
+		_, err := strconv.ParseUint(value, 0, bitSize)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		return astutil.NewBasicLit(token.INT, value), nil
+	}
+
+	if bitSize, ok := floatBitSizes[typeName]; ok {
+		_, err := strconv.ParseFloat(value, bitSize)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		return astutil.NewBasicLit(token.FLOAT, value), nil
+	}
+
+	return nil, errors.Errorf("unsupported type: %s", typeName)
+}
+
+func isNumberLiteral(value string) bool {
+	expr, err := parser.ParseExpr(value)
+	if err != nil {
+		return false
+	}
+
+	if unaryExpr, ok := expr.(*ast.UnaryExpr); ok && (unaryExpr.Op == token.SUB || unaryExpr.Op == token.ADD) {
+		expr = unaryExpr.X
+	}
+
+	basicLit, ok := expr.(*ast.BasicLit)
+
+	return ok && (basicLit.Kind == token.INT || basicLit.Kind == token.FLOAT)
+}
+
+// durationUnits lists the units of time.Duration from the largest, to write durations as e.g. 90 * time.Second.
+var durationUnits = []struct {
+	name string
+	d    time.Duration
+}{
+	{"Hour", time.Hour},
+	{"Minute", time.Minute},
+	{"Second", time.Second},
+	{"Millisecond", time.Millisecond},
+	{"Microsecond", time.Microsecond},
+	{"Nanosecond", time.Nanosecond},
+}
+
+func durationExpr(packageExpr ast.Expr, d time.Duration) ast.Expr {
+	if d == 0 {
+		return astutil.NewBasicLit(token.INT, "0")
+	}
+
+	for _, unit := range durationUnits {
+		if d%unit.d != 0 {
+			continue
+		}
+
+		unitExpr := astutil.NewSelectorExpr(packageExpr, astutil.NewIdent(unit.name))
+
+		n := int64(d / unit.d)
+		if n == 1 {
+			return unitExpr
+		}
+
+		return &ast.BinaryExpr{
+			X:  astutil.NewBasicLit(token.INT, strconv.FormatInt(n, 10)),
+			Op: token.MUL,
+			Y:  unitExpr,
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) buildDefaultReturnStmt(field *ast.Field, defaultExpr ast.Expr) ast.Stmt {
+	return &ast.IfStmt{
+		Cond: g.buildZeroCheckExpr(
+			astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name)),
+			field.Type,
+		),
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				astutil.NewReturnStmt(
+					[]ast.Expr{
+						defaultExpr,
+					},
+				),
+			},
+		),
+	}
+}
+
+func (g *Generator) applyDefaultsFuncDecl(structName string, fieldList *ast.FieldList) ast.Decl {
+	var stmts []ast.Stmt
+
+	for _, field := range fieldList.List {
+		if !g.isPropertyField(field) {
+			continue
+		}
+
+		defaultExpr := g.defaultValueExprOf(field)
+		if defaultExpr == nil {
+			continue
+		}
+
+		selectorExpr := astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name))
+
+		stmts = append(stmts, &ast.IfStmt{
+			Cond: g.buildZeroCheckExpr(selectorExpr, field.Type),
+			Body: astutil.NewBlockStmt(
+				[]ast.Stmt{
+					astutil.NewAssignStmt(
+						[]ast.Expr{
+							selectorExpr,
+						},
+						token.ASSIGN,
+						[]ast.Expr{
+							defaultExpr,
+						},
+					),
+				},
+			),
+		})
+	}
+
+	if len(stmts) == 0 {
+		return nil
+	}
+
+	return &ast.FuncDecl{
+		Doc: &ast.CommentGroup{
+			List: []*ast.Comment{
+				{Text: "// ApplyDefaults sets the fields holding their zero value to their defaults."},
+			},
+		},
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("ApplyDefaults"),
+		Type: astutil.NewFuncType(nil, nil, nil),
+		Body: astutil.NewBlockStmt(stmts),
+	}
+}
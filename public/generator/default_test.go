@@ -0,0 +1,145 @@
+package generator
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultValueExpr(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name      string
+		fieldType ast.Expr
+		value     string
+		want      string
+		wantErr   error
+	}{
+		{
+			name:      "success: string",
+			fieldType: &ast.Ident{Name: "string"},
+			value:     `say "hello"`,
+			want:      `"say \"hello\""`,
+		},
+		{
+			name:      "success: bool",
+			fieldType: &ast.Ident{Name: "bool"},
+			value:     "1",
+			want:      "true",
+		},
+		{
+			name:      "success: hexadecimal float",
+			fieldType: &ast.Ident{Name: "float64"},
+			value:     "0x1p-2",
+			want:      "0x1p-2",
+		},
+		{
+			name:      "success: int",
+			fieldType: &ast.Ident{Name: "int"},
+			value:     "-10",
+			want:      "-10",
+		},
+		{
+			name:      "success: uint8",
+			fieldType: &ast.Ident{Name: "uint8"},
+			value:     "255",
+			want:      "255",
+		},
+		{
+			name:      "success: float64",
+			fieldType: &ast.Ident{Name: "float64"},
+			value:     "1.5",
+			want:      "1.5",
+		},
+		{
+			name:      "success: time.Duration",
+			fieldType: &ast.SelectorExpr{X: &ast.Ident{Name: "time"}, Sel: &ast.Ident{Name: "Duration"}},
+			value:     "5s",
+			want:      "5 * time.Second",
+		},
+		{
+			name:      "success: time.Duration of mixed units",
+			fieldType: &ast.SelectorExpr{X: &ast.Ident{Name: "time"}, Sel: &ast.Ident{Name: "Duration"}},
+			value:     "1m30s",
+			want:      "90 * time.Second",
+		},
+		{
+			name:      "success: time.Duration of one unit",
+			fieldType: &ast.SelectorExpr{X: &ast.Ident{Name: "time"}, Sel: &ast.Ident{Name: "Duration"}},
+			value:     "1h",
+			want:      "time.Hour",
+		},
+		{
+			name:      "success: time.Duration of milliseconds",
+			fieldType: &ast.SelectorExpr{X: &ast.Ident{Name: "time"}, Sel: &ast.Ident{Name: "Duration"}},
+			value:     "1.5s",
+			want:      "1500 * time.Millisecond",
+		},
+		{
+			name:      "failure: int out of range",
+			fieldType: &ast.Ident{Name: "int8"},
+			value:     "128",
+			wantErr:   errInvalidDefaultValue,
+		},
+		{
+			name:      "failure: invalid bool",
+			fieldType: &ast.Ident{Name: "bool"},
+			value:     "yes",
+			wantErr:   errInvalidDefaultValue,
+		},
+		{
+			name:      "failure: NaN",
+			fieldType: &ast.Ident{Name: "float64"},
+			value:     "NaN",
+			wantErr:   errInvalidDefaultValue,
+		},
+		{
+			name:      "failure: infinity",
+			fieldType: &ast.Ident{Name: "float32"},
+			value:     "-Inf",
+			wantErr:   errInvalidDefaultValue,
+		},
+		{
+			name:      "failure: invalid duration",
+			fieldType: &ast.SelectorExpr{X: &ast.Ident{Name: "time"}, Sel: &ast.Ident{Name: "Duration"}},
+			value:     "5 seconds",
+			wantErr:   errInvalidDefaultValue,
+		},
+		{
+			name:      "failure: unsupported type",
+			fieldType: &ast.StarExpr{X: &ast.Ident{Name: "int"}},
+			value:     "1",
+			wantErr:   errInvalidDefaultValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := generator.defaultValueExpr(tt.fieldType, tt.value)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			got := bytes.NewBuffer([]byte{})
+
+			require.NoError(t, format.Node(got, token.NewFileSet(), expr))
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
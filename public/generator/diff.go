@@ -0,0 +1,176 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"github.com/hidori/go-astutil"
+	"github.com/hidori/go-typeutil"
+)
+
+// fieldChangeName is the name of the change type shared by the Diff methods of the package.
+const fieldChangeName = "FieldChange"
+
+// diffFuncDecl returns Diff() that reports the property fields whose values differ.
+// Slices and maps are copied into the changes, so that they do not share their elements with the structs.
+func (g *Generator) diffFuncDecl(structName string, fieldList *ast.FieldList) (ast.Decl, error) {
+	stmts := []ast.Stmt{
+		&ast.IfStmt{
+			Cond: &ast.BinaryExpr{
+				Op: token.LOR,
+				X:  &ast.BinaryExpr{Op: token.EQL, X: g.recvIdent(), Y: astutil.NewIdent("nil")},
+				Y:  &ast.BinaryExpr{Op: token.EQL, X: astutil.NewIdent("other"), Y: astutil.NewIdent("nil")},
+			},
+			Body: astutil.NewBlockStmt(
+				[]ast.Stmt{
+					astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("nil")}),
+				},
+			),
+		},
+		g.buildVarStmt("changes", &ast.ArrayType{Elt: astutil.NewIdent(fieldChangeName)}),
+	}
+
+	for _, field := range g.propertyFields(fieldList, nil) {
+		notEqualExpr, err := g.buildNotEqualExpr(field)
+		if err != nil {
+			return nil, err
+		}
+
+		name := field.Names[0].Name
+
+		oldExpr := ast.Expr(astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(name)))
+		newExpr := ast.Expr(astutil.NewSelectorExpr(astutil.NewIdent("other"), astutil.NewIdent(name)))
+
+		var copyStmts []ast.Stmt
+
+		switch {
+		case g.hasDirective(field, "redact"):
+			oldExpr = astutil.NewBasicLit(token.STRING, strconv.Quote(redactedValue))
+			newExpr = astutil.NewBasicLit(token.STRING, strconv.Quote(redactedValue))
+
+		case isCollectionType(field.Type) && g.isDeepCopyType(elemTypeOf(field.Type)):
+			copyStmts = []ast.Stmt{
+				g.buildVarStmt("oldValue", field.Type),
+				g.buildVarStmt("newValue", field.Type),
+				g.buildCloneStmt(astutil.NewIdent("oldValue"), oldExpr, field.Type, 0),
+				g.buildCloneStmt(astutil.NewIdent("newValue"), newExpr, field.Type, 0),
+			}
+			oldExpr = astutil.NewIdent("oldValue")
+			newExpr = astutil.NewIdent("newValue")
+
+		default:
+			oldExpr = g.buildCopyExpr(oldExpr, field.Type)
+			newExpr = g.buildCopyExpr(newExpr, field.Type)
+		}
+
+		stmts = append(stmts, &ast.IfStmt{
+			Cond: notEqualExpr,
+			Body: astutil.NewBlockStmt(
+				append(copyStmts,
+					astutil.NewAssignStmt(
+						[]ast.Expr{astutil.NewIdent("changes")},
+						token.ASSIGN,
+						[]ast.Expr{
+							&ast.CallExpr{
+								Fun: astutil.NewIdent("append"),
+								Args: []ast.Expr{
+									astutil.NewIdent("changes"),
+									&ast.CompositeLit{
+										Type: astutil.NewIdent(fieldChangeName),
+										Elts: []ast.Expr{
+											&ast.KeyValueExpr{Key: astutil.NewIdent("Field"), Value: astutil.NewBasicLit(token.STRING, strconv.Quote(name))},
+											&ast.KeyValueExpr{Key: astutil.NewIdent("Old"), Value: oldExpr},
+											&ast.KeyValueExpr{Key: astutil.NewIdent("New"), Value: newExpr},
+										},
+									},
+								},
+							},
+						},
+					),
+				),
+			),
+		})
+	}
+
+	stmts = append(stmts, astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("changes")}))
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("Diff"),
+		Type: g.buildOtherFuncType(structName, &ast.ArrayType{Elt: astutil.NewIdent(fieldChangeName)}),
+		Body: astutil.NewBlockStmt(stmts),
+	}, nil
+}
+
+// fieldChangeDecl returns the FieldChange type, which is generated once per file
+// unless a file of the package that is not generated already declares it.
+func fieldChangeDecl() ast.Decl {
+	return &ast.GenDecl{
+		Tok: token.TYPE,
+		Specs: []ast.Spec{
+			&ast.TypeSpec{
+				Name: astutil.NewIdent(fieldChangeName),
+				Type: &ast.StructType{
+					Fields: astutil.NewFieldList(
+						[]*ast.Field{
+							astutil.NewField([]*ast.Ident{astutil.NewIdent("Field")}, astutil.NewIdent("string")),
+							astutil.NewField([]*ast.Ident{astutil.NewIdent("Old")}, astutil.NewIdent("any")),
+							astutil.NewField([]*ast.Ident{astutil.NewIdent("New")}, astutil.NewIdent("any")),
+						},
+					),
+				},
+			},
+		},
+	}
+}
+
+// declaresType reports whether the files that are not generated declare the type.
+func declaresType(typeName string, files ...*ast.File) bool {
+	for _, file := range files {
+		if ast.IsGenerated(file) {
+			continue
+		}
+
+		for _, d := range file.Decls {
+			genDecl := typeutil.AsOrEmpty[*ast.GenDecl](d)
+			if genDecl == nil || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, s := range genDecl.Specs {
+				if typeSpec := typeutil.AsOrEmpty[*ast.TypeSpec](s); typeSpec != nil && typeSpec.Name.Name == typeName {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func isCollectionType(fieldType ast.Expr) bool {
+	switch t := fieldType.(type) {
+	case *ast.ArrayType:
+		return t.Len == nil
+
+	case *ast.MapType:
+		return true
+
+	default:
+		return false
+	}
+}
+
+func elemTypeOf(fieldType ast.Expr) ast.Expr {
+	switch t := fieldType.(type) {
+	case *ast.ArrayType:
+		return t.Elt
+
+	case *ast.MapType:
+		return t.Value
+
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,143 @@
+package generator
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffFuncDecl(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name      string
+		fieldType ast.Expr
+		tag       string
+		wantOld   ast.Expr
+		wantErr   bool
+	}{
+		{
+			name:      "success: reports field values",
+			fieldType: &ast.Ident{Name: "string"},
+			tag:       "`property:\"get\"`",
+			wantOld:   &ast.SelectorExpr{},
+		},
+		{
+			name:      "success: redacts field values",
+			fieldType: &ast.Ident{Name: "string"},
+			tag:       "`property:\"get,redact\"`",
+			wantOld:   &ast.BasicLit{},
+		},
+		{
+			name:      "success: copies slices",
+			fieldType: &ast.ArrayType{Elt: &ast.Ident{Name: "string"}},
+			tag:       "`property:\"get\"`",
+			wantOld:   &ast.CallExpr{},
+		},
+		{
+			name:      "success: copies slices of slices deeply",
+			fieldType: &ast.ArrayType{Elt: &ast.ArrayType{Elt: &ast.Ident{Name: "string"}}},
+			tag:       "`property:\"get\"`",
+			wantOld:   &ast.Ident{},
+		},
+		{
+			name:      "failure: func field",
+			fieldType: &ast.FuncType{},
+			tag:       "`property:\"get\"`",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fieldList := &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{{Name: "value"}},
+						Type:  tt.fieldType,
+						Tag:   &ast.BasicLit{Value: tt.tag},
+					},
+				},
+			}
+
+			decl, err := generator.diffFuncDecl("TestStruct", fieldList)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errInvalidFieldType)
+				return
+			}
+
+			require.NoError(t, err)
+
+			funcDecl := decl.(*ast.FuncDecl)
+			ifStmt := funcDecl.Body.List[2].(*ast.IfStmt)
+			appendCall := ifStmt.Body.List[len(ifStmt.Body.List)-1].(*ast.AssignStmt).Rhs[0].(*ast.CallExpr)
+			change := appendCall.Args[1].(*ast.CompositeLit)
+
+			assert.IsType(t, tt.wantOld, change.Elts[1].(*ast.KeyValueExpr).Value)
+		})
+	}
+}
+
+func TestDeclaresType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		srcs []string
+		want bool
+	}{
+		{
+			name: "success: declared in a file",
+			srcs: []string{`package data
+type User struct{}
+`, `package data
+type FieldChange struct{}
+`},
+			want: true,
+		},
+		{
+			name: "success: declared only in a generated file",
+			srcs: []string{`// Code generated by genprop DO NOT EDIT.
+
+package data
+type FieldChange struct{}
+`},
+			want: false,
+		},
+		{
+			name: "success: not declared",
+			srcs: []string{`package data
+type User struct{}
+`},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var files []*ast.File
+
+			for _, src := range tt.srcs {
+				file, err := parser.ParseFile(token.NewFileSet(), "", src, parser.AllErrors|parser.ParseComments)
+				require.NoError(t, err)
+
+				files = append(files, file)
+			}
+
+			assert.Equal(t, tt.want, declaresType("FieldChange", files...))
+		})
+	}
+}
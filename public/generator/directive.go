@@ -0,0 +1,104 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var optionDirectives = map[string]bool{
+	"before": true,
+	"after":  true,
+	"lazy":   true,
+	"once":   true,
+}
+
+func (g *Generator) directiveValue(field *ast.Field, key string) (string, bool) {
+	propertyTag := g.propertyTagOf(field)
+
+	for _, directive := range strings.Split(propertyTag, ",") {
+		k, v, found := strings.Cut(directive, "=")
+		if found && k == key {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+func (g *Generator) hasDirective(field *ast.Field, name string) bool {
+	propertyTag := g.propertyTagOf(field)
+
+	for _, directive := range strings.Split(propertyTag, ",") {
+		if directive == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// propertyTagOf returns the value of the first tag of TagName and FallbackTagNames that the field has.
+func (g *Generator) propertyTagOf(field *ast.Field) string {
+	tag := structTag(field)
+
+	for _, name := range g.tagNames() {
+		value, ok := tag.Lookup(name)
+		if ok {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (g *Generator) tagNames() []string {
+	return append([]string{g.config.TagName}, g.config.FallbackTagNames...)
+}
+
+// warnMultipleTags warns when the field has more than one of TagName and FallbackTagNames.
+func (g *Generator) warnMultipleTags(structName string, field *ast.Field) {
+	if g.config.Warn == nil || len(field.Names) == 0 {
+		return
+	}
+
+	tag := structTag(field)
+
+	var names []string
+
+	for _, name := range g.tagNames() {
+		if _, ok := tag.Lookup(name); ok {
+			names = append(names, strconv.Quote(name))
+		}
+	}
+
+	if len(names) > 1 {
+		g.config.Warn(fmt.Sprintf("field %s.%s has tags %s, using %s",
+			structName, field.Names[0].Name, strings.Join(names, ", "), names[0]))
+	}
+}
+
+func structTag(field *ast.Field) reflect.StructTag {
+	if field.Tag == nil {
+		return ""
+	}
+
+	tagValue, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return ""
+	}
+
+	return reflect.StructTag(tagValue)
+}
+
+func (g *Generator) isPropertyField(field *ast.Field) bool {
+	if len(field.Names) == 0 {
+		return false
+	}
+
+	propertyTag := g.propertyTagOf(field)
+
+	return propertyTag != "" && propertyTag != "-"
+}
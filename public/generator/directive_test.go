@@ -0,0 +1,111 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPropertyTagOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		tag  string
+		want string
+	}{
+		{
+			name: "success: tag name",
+			tag:  "`property:\"get\"`",
+			want: "get",
+		},
+		{
+			name: "success: fallback tag name",
+			tag:  "`prop:\"get,set\"`",
+			want: "get,set",
+		},
+		{
+			name: "success: tag name takes precedence",
+			tag:  "`prop:\"get,set\" property:\"get\"`",
+			want: "get",
+		},
+		{
+			name: "success: empty tag name value takes precedence",
+			tag:  "`property:\"\" prop:\"get\"`",
+			want: "",
+		},
+		{
+			name: "success: no tag",
+			tag:  "`json:\"name\"`",
+			want: "",
+		},
+	}
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName:          tagName,
+		FallbackTagNames: []string{"prop"},
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			field := &ast.Field{
+				Names: []*ast.Ident{{Name: "name"}},
+				Type:  &ast.Ident{Name: "string"},
+				Tag:   &ast.BasicLit{Kind: token.STRING, Value: tt.tag},
+			}
+
+			assert.Equal(t, tt.want, generator.propertyTagOf(field))
+		})
+	}
+}
+
+func TestWarnMultipleTags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		tag  string
+		want []string
+	}{
+		{
+			name: "success: warns for both tags",
+			tag:  "`property:\"get\" prop:\"get,set\"`",
+			want: []string{`field User.name has tags "property", "prop", using "property"`},
+		},
+		{
+			name: "success: no warning for a single tag",
+			tag:  "`prop:\"get\"`",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			generator := NewGenerator(&GeneratorConfig{
+				TagName:          tagName,
+				FallbackTagNames: []string{"prop"},
+				Warn: func(message string) {
+					got = append(got, message)
+				},
+			})
+
+			field := &ast.Field{
+				Names: []*ast.Ident{{Name: "name"}},
+				Type:  &ast.Ident{Name: "string"},
+				Tag:   &ast.BasicLit{Kind: token.STRING, Value: tt.tag},
+			}
+
+			generator.warnMultipleTags("User", field)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
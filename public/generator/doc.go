@@ -0,0 +1,38 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+// accessorDoc returns the doc comment of a generated accessor.
+// The doc and line comments of the field follow the summary as paragraphs of their own, so that a paragraph starting
+// with "Deprecated:" stays a deprecation notice and tools such as staticcheck warn users of the accessor.
+func accessorDoc(funcName string, summary string, field *ast.Field) *ast.CommentGroup {
+	lines := []string{fmt.Sprintf("%s %s", funcName, summary)}
+
+	for _, commentGroup := range []*ast.CommentGroup{field.Doc, field.Comment} {
+		text := strings.TrimSpace(commentGroup.Text())
+		if text == "" {
+			continue
+		}
+
+		lines = append(lines, "")
+		lines = append(lines, strings.Split(text, "\n")...)
+	}
+
+	comments := make([]*ast.Comment, 0, len(lines))
+
+	for _, line := range lines {
+		if line == "" {
+			comments = append(comments, &ast.Comment{Text: "//"})
+
+			continue
+		}
+
+		comments = append(comments, &ast.Comment{Text: "// " + line})
+	}
+
+	return &ast.CommentGroup{List: comments}
+}
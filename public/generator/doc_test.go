@@ -0,0 +1,123 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessorDoc(t *testing.T) {
+	t.Parallel()
+
+	commentGroupOf := func(texts ...string) *ast.CommentGroup {
+		comments := make([]*ast.Comment, 0, len(texts))
+		for _, text := range texts {
+			comments = append(comments, &ast.Comment{Text: text})
+		}
+
+		return &ast.CommentGroup{List: comments}
+	}
+
+	tests := []struct {
+		name  string
+		field *ast.Field
+		want  []string
+	}{
+		{
+			name:  "success: no comment",
+			field: &ast.Field{},
+			want: []string{
+				"// GetName returns the name.",
+			},
+		},
+		{
+			name: "success: doc comment",
+			field: &ast.Field{
+				Doc: commentGroupOf("// The display name.", "// Shown in the header."),
+			},
+			want: []string{
+				"// GetName returns the name.",
+				"//",
+				"// The display name.",
+				"// Shown in the header.",
+			},
+		},
+		{
+			name: "success: doc and line comments",
+			field: &ast.Field{
+				Doc:     commentGroupOf("// The display name."),
+				Comment: commentGroupOf("// Shown in the header."),
+			},
+			want: []string{
+				"// GetName returns the name.",
+				"//",
+				"// The display name.",
+				"//",
+				"// Shown in the header.",
+			},
+		},
+		{
+			name: "success: deprecated line comment",
+			field: &ast.Field{
+				Comment: commentGroupOf("// Deprecated: use fullName instead."),
+			},
+			want: []string{
+				"// GetName returns the name.",
+				"//",
+				"// Deprecated: use fullName instead.",
+			},
+		},
+		{
+			name: "success: deprecated paragraph in doc comment",
+			field: &ast.Field{
+				Doc: commentGroupOf("// The display name.", "//", "// Deprecated: use fullName instead."),
+			},
+			want: []string{
+				"// GetName returns the name.",
+				"//",
+				"// The display name.",
+				"//",
+				"// Deprecated: use fullName instead.",
+			},
+		},
+		{
+			name: "success: deprecated in the middle of a line is not a notice",
+			field: &ast.Field{
+				Doc: commentGroupOf("// The display name. Deprecated: use fullName instead."),
+			},
+			want: []string{
+				"// GetName returns the name.",
+				"//",
+				"// The display name. Deprecated: use fullName instead.",
+			},
+		},
+		{
+			name: "success: deprecated on the next line of a paragraph is not a notice",
+			field: &ast.Field{
+				Doc: commentGroupOf("// The display name.", "// Deprecated: use fullName instead."),
+			},
+			want: []string{
+				"// GetName returns the name.",
+				"//",
+				"// The display name.",
+				"// Deprecated: use fullName instead.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := accessorDoc("GetName", "returns the name.", tt.field)
+
+			var got []string
+			for _, comment := range doc.List {
+				got = append(got, comment.Text)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
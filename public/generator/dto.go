@@ -0,0 +1,216 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/hidori/go-astutil"
+	"github.com/hidori/go-typeutil"
+	"github.com/pkg/errors"
+)
+
+const mapTagName = "map"
+
+var errDTOFieldNotFound = errors.New("dto field not found")
+
+func isTypeName(value string) bool {
+	pkgName, typeName, found := strings.Cut(value, ".")
+	if !found {
+		return token.IsIdentifier(value)
+	}
+
+	return token.IsIdentifier(pkgName) && token.IsIdentifier(typeName)
+}
+
+// structTypesOf returns the struct types declared in the files, keyed by the type name.
+func structTypesOf(files ...*ast.File) map[string]*ast.StructType {
+	structTypes := map[string]*ast.StructType{}
+
+	for _, file := range files {
+		for _, d := range file.Decls {
+			genDecl := typeutil.AsOrEmpty[*ast.GenDecl](d)
+			if genDecl == nil || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, s := range genDecl.Specs {
+				typeSpec := typeutil.AsOrEmpty[*ast.TypeSpec](s)
+				if typeSpec == nil {
+					continue
+				}
+
+				if structType := typeutil.AsOrEmpty[*ast.StructType](typeSpec.Type); structType != nil {
+					structTypes[typeSpec.Name.Name] = structType
+				}
+			}
+		}
+	}
+
+	return structTypes
+}
+
+func (g *Generator) dtoFuncDecls(structName string, fieldList *ast.FieldList, dto string) ([]ast.Decl, error) {
+	fields := g.propertyFields(fieldList, func(field *ast.Field) bool {
+		return structTag(field).Get(mapTagName) != "-"
+	})
+
+	dtoType := ast.Expr(astutil.NewIdent(dto))
+	dtoName := dto
+	dtoFieldNames := g.dtoFieldNamesOf(dto)
+
+	if pkgName, typeName, found := strings.Cut(dto, "."); found {
+		dtoType = astutil.NewSelectorExpr(astutil.NewIdent(pkgName), astutil.NewIdent(typeName))
+		dtoName = typeName
+	}
+
+	names := make(map[*ast.Field]string, len(fields))
+
+	for _, field := range fields {
+		name, err := g.dtoFieldNameOf(field, dtoFieldNames)
+		if err != nil {
+			return nil, errors.Wrapf(err, "struct=%s dto=%s", structName, dto)
+		}
+
+		names[field] = name
+	}
+
+	return []ast.Decl{
+		g.toDTOFuncDecl(structName, dtoName, dtoType, fields, names),
+		g.fromDTOFuncDecl(structName, dtoName, dtoType, fields, names),
+	}, nil
+}
+
+// dtoFieldNamesOf returns the field names of the DTO type when it is a struct declared in the package.
+// It returns nil for types of other packages and structs with embedded fields, whose fields can not be listed.
+func (g *Generator) dtoFieldNamesOf(dto string) []string {
+	structType, ok := g.structTypes[dto]
+	if !ok {
+		return nil
+	}
+
+	names := []string{}
+
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 {
+			return nil
+		}
+
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+	}
+
+	return names
+}
+
+// dtoFieldNameOf returns the name of the DTO field mapped to the field, which is the name in the map tag
+// or the field name with initialisms applied as in the getters.
+// When the fields of the DTO are known, the name is matched case-insensitively, so that "Id" maps to "ID".
+func (g *Generator) dtoFieldNameOf(field *ast.Field, dtoFieldNames []string) (string, error) {
+	name := structTag(field).Get(mapTagName)
+	if name == "" {
+		name = g.prepareFieldName(field.Names[0].Name)
+	}
+
+	if dtoFieldNames == nil {
+		return name, nil
+	}
+
+	for _, dtoFieldName := range dtoFieldNames {
+		if dtoFieldName == name {
+			return dtoFieldName, nil
+		}
+	}
+
+	for _, dtoFieldName := range dtoFieldNames {
+		if strings.EqualFold(dtoFieldName, name) {
+			return dtoFieldName, nil
+		}
+	}
+
+	return "", errors.Wrapf(errDTOFieldNotFound, "field=%s name=%s", field.Names[0].Name, name)
+}
+
+func (g *Generator) toDTOFuncDecl(structName string, dtoName string, dtoType ast.Expr, fields []*ast.Field, names map[*ast.Field]string) ast.Decl {
+	stmts := []ast.Stmt{
+		g.buildVarStmt("v", dtoType),
+	}
+
+	for _, field := range fields {
+		valueExpr := ast.Expr(astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name)))
+
+		if getterName, ok := g.getterNameOf(field); ok {
+			valueExpr = &ast.CallExpr{
+				Fun: astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(getterName)),
+			}
+		}
+
+		stmts = append(stmts, astutil.NewAssignStmt(
+			[]ast.Expr{
+				astutil.NewSelectorExpr(astutil.NewIdent("v"), astutil.NewIdent(names[field])),
+			},
+			token.ASSIGN,
+			[]ast.Expr{
+				valueExpr,
+			},
+		))
+	}
+
+	stmts = append(stmts, astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("v")}))
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("To" + dtoName),
+		Type: astutil.NewFuncType(
+			nil,
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(nil, dtoType),
+				},
+			),
+		),
+		Body: astutil.NewBlockStmt(stmts),
+	}
+}
+
+// fromDTOFuncDecl returns From<Type>, which assigns only the fields with a setter,
+// so that read-only fields can not be overwritten from transport input.
+func (g *Generator) fromDTOFuncDecl(structName string, dtoName string, dtoType ast.Expr, fields []*ast.Field, names map[*ast.Field]string) ast.Decl {
+	var settableFields []*ast.Field
+
+	for _, field := range fields {
+		if _, ok := g.setterNameOf(field); ok {
+			settableFields = append(settableFields, field)
+		}
+	}
+
+	stmts := g.buildAssignFieldsStmts(settableFields, func(field *ast.Field) ast.Expr {
+		return astutil.NewSelectorExpr(astutil.NewIdent("v"), astutil.NewIdent(names[field]))
+	}, nil)
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("From" + dtoName),
+		Type: astutil.NewFuncType(
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(
+						[]*ast.Ident{
+							astutil.NewIdent("v"),
+						},
+						dtoType,
+					),
+				},
+			),
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(nil, astutil.NewIdent("error")),
+				},
+			),
+		),
+		Body: astutil.NewBlockStmt(stmts),
+	}
+}
@@ -0,0 +1,134 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTypeName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value string
+		want  bool
+	}{
+		{
+			name:  "success: local type",
+			value: "UserRow",
+			want:  true,
+		},
+		{
+			name:  "success: qualified type",
+			value: "api.UserResponse",
+			want:  true,
+		},
+		{
+			name:  "failure: empty",
+			value: "",
+			want:  false,
+		},
+		{
+			name:  "failure: nested selector",
+			value: "api.v1.UserResponse",
+			want:  false,
+		},
+		{
+			name:  "failure: import path",
+			value: "example.com/api.UserResponse",
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, isTypeName(tt.value))
+		})
+	}
+}
+
+func TestDTOFieldNameOf(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName:    tagName,
+		Initialism: []string{"id"},
+	})
+
+	tests := []struct {
+		name          string
+		fieldName     string
+		tag           string
+		dtoFieldNames []string
+		want          string
+		wantErr       bool
+	}{
+		{
+			name:      "success: field name",
+			fieldName: "userID",
+			tag:       "`property:\"get\"`",
+			want:      "UserID",
+		},
+		{
+			name:      "success: map tag",
+			fieldName: "name",
+			tag:       "`property:\"get\" map:\"FullName\"`",
+			want:      "FullName",
+		},
+		{
+			name:          "success: exact match",
+			fieldName:     "id",
+			tag:           "`property:\"get\"`",
+			dtoFieldNames: []string{"Id", "ID"},
+			want:          "ID",
+		},
+		{
+			name:          "success: case-insensitive match",
+			fieldName:     "apiKey",
+			tag:           "`property:\"get\"`",
+			dtoFieldNames: []string{"ID", "APIKey"},
+			want:          "APIKey",
+		},
+		{
+			name:          "failure: field not found",
+			fieldName:     "email",
+			tag:           "`property:\"get\"`",
+			dtoFieldNames: []string{"ID", "Mail"},
+			wantErr:       true,
+		},
+		{
+			name:          "failure: map tag not found",
+			fieldName:     "name",
+			tag:           "`property:\"get\" map:\"FullName\"`",
+			dtoFieldNames: []string{"Name"},
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			field := &ast.Field{
+				Names: []*ast.Ident{{Name: tt.fieldName}},
+				Type:  &ast.Ident{Name: "string"},
+				Tag:   &ast.BasicLit{Value: tt.tag},
+			}
+
+			got, err := generator.dtoFieldNameOf(field, tt.dtoFieldNames)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errDTOFieldNotFound)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
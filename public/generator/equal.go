@@ -0,0 +1,421 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/hidori/go-astutil"
+	"github.com/hidori/go-typeutil"
+	"github.com/pkg/errors"
+)
+
+func (g *Generator) equalFuncDecl(structName string, fieldList *ast.FieldList) (ast.Decl, error) {
+	stmts := []ast.Stmt{
+		&ast.IfStmt{
+			Cond: &ast.BinaryExpr{
+				Op: token.LOR,
+				X:  &ast.BinaryExpr{Op: token.EQL, X: g.recvIdent(), Y: astutil.NewIdent("nil")},
+				Y:  &ast.BinaryExpr{Op: token.EQL, X: astutil.NewIdent("other"), Y: astutil.NewIdent("nil")},
+			},
+			Body: astutil.NewBlockStmt(
+				[]ast.Stmt{
+					astutil.NewReturnStmt(
+						[]ast.Expr{
+							&ast.BinaryExpr{Op: token.EQL, X: g.recvIdent(), Y: astutil.NewIdent("other")},
+						},
+					),
+				},
+			),
+		},
+	}
+
+	for _, field := range g.propertyFields(fieldList, nil) {
+		notEqualExpr, err := g.buildNotEqualExpr(field)
+		if err != nil {
+			return nil, err
+		}
+
+		stmts = append(stmts, &ast.IfStmt{
+			Cond: notEqualExpr,
+			Body: astutil.NewBlockStmt(
+				[]ast.Stmt{
+					astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("false")}),
+				},
+			),
+		})
+	}
+
+	stmts = append(stmts, astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("true")}))
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("Equal"),
+		Type: g.buildOtherFuncType(structName, astutil.NewIdent("bool")),
+		Body: astutil.NewBlockStmt(stmts),
+	}, nil
+}
+
+func (g *Generator) buildNotEqualExpr(field *ast.Field) (ast.Expr, error) {
+	x := astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name))
+	y := astutil.NewSelectorExpr(astutil.NewIdent("other"), astutil.NewIdent(field.Names[0].Name))
+
+	equalExpr, err := g.buildEqualExpr(x, y, field.Type)
+	if err != nil {
+		return nil, errors.Wrapf(err, "field=%s", field.Names[0].Name)
+	}
+
+	if binaryExpr := typeutil.AsOrEmpty[*ast.BinaryExpr](equalExpr); binaryExpr != nil && binaryExpr.Op == token.EQL {
+		return &ast.BinaryExpr{Op: token.NEQ, X: binaryExpr.X, Y: binaryExpr.Y}, nil
+	}
+
+	if _, ok := equalExpr.(*ast.BinaryExpr); ok {
+		equalExpr = &ast.ParenExpr{X: equalExpr}
+	}
+
+	return &ast.UnaryExpr{Op: token.NOT, X: equalExpr}, nil
+}
+
+// buildEqualExpr returns an expression that reports whether x and y of the given type are equal.
+// Slices and maps whose elements can not be compared with == are compared element by element.
+func (g *Generator) buildEqualExpr(x ast.Expr, y ast.Expr, fieldType ast.Expr) (ast.Expr, error) {
+	callExpr := func(fun ast.Expr, args ...ast.Expr) ast.Expr {
+		return &ast.CallExpr{Fun: fun, Args: args}
+	}
+
+	switch t := fieldType.(type) {
+	case *ast.FuncType:
+		return nil, errors.Wrap(errInvalidFieldType, "equal does not support func types")
+
+	case *ast.StructType:
+		return nil, errors.Wrap(errInvalidFieldType, "equal does not support anonymous struct types")
+
+	case *ast.MapType:
+		return g.buildCollectionEqualExpr("maps", x, y, t.Value)
+
+	case *ast.ArrayType:
+		if t.Len == nil && (isIdentType(t.Elt, "byte") || isIdentType(t.Elt, "uint8")) {
+			return callExpr(astutil.NewSelectorExpr(astutil.NewIdent("bytes"), astutil.NewIdent("Equal")), x, y), nil
+		}
+
+		if t.Len == nil {
+			return g.buildCollectionEqualExpr("slices", x, y, t.Elt)
+		}
+
+		equalExpr, err := g.buildCollectionEqualExpr("slices", sliceOf(x), sliceOf(y), t.Elt)
+		if err != nil {
+			return nil, err
+		}
+
+		if isFuncCallOf(equalExpr, "slices", "Equal") {
+			return &ast.BinaryExpr{Op: token.EQL, X: x, Y: y}, nil
+		}
+
+		return equalExpr, nil
+
+	case *ast.StarExpr:
+		if ident := typeutil.AsOrEmpty[*ast.Ident](t.X); ident != nil && g.equalTypes[ident.Name] {
+			return callExpr(selectorOf(x, "Equal"), y), nil
+		}
+
+		pointeeEqualExpr, err := g.buildEqualExpr(&ast.StarExpr{X: x}, &ast.StarExpr{X: y}, t.X)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ast.BinaryExpr{
+			Op: token.LOR,
+			X:  &ast.BinaryExpr{Op: token.EQL, X: x, Y: y},
+			Y: &ast.BinaryExpr{
+				Op: token.LAND,
+				X: &ast.BinaryExpr{
+					Op: token.LAND,
+					X:  &ast.BinaryExpr{Op: token.NEQ, X: x, Y: astutil.NewIdent("nil")},
+					Y:  &ast.BinaryExpr{Op: token.NEQ, X: y, Y: astutil.NewIdent("nil")},
+				},
+				Y: pointeeEqualExpr,
+			},
+		}, nil
+
+	case *ast.Ident:
+		if g.equalTypes[t.Name] {
+			return callExpr(selectorOf(x, "Equal"), &ast.UnaryExpr{Op: token.AND, X: y}), nil
+		}
+
+		if underlyingType, ok := g.namedTypes[t.Name]; ok {
+			if g.isRecursiveType(t.Name) {
+				return nil, errors.Wrapf(errInvalidFieldType, "equal does not support recursive types: type=%s", t.Name)
+			}
+
+			return g.buildEqualExpr(x, y, underlyingType)
+		}
+	}
+
+	if isTimeType(fieldType) {
+		return callExpr(selectorOf(x, "Equal"), y), nil
+	}
+
+	return &ast.BinaryExpr{Op: token.EQL, X: x, Y: y}, nil
+}
+
+// namedTypesOf returns the underlying types of the non-struct types declared in the files, keyed by the type name.
+// Equal resolves them so that named slices and maps, such as type Tags []string, are compared element by element.
+func namedTypesOf(files ...*ast.File) map[string]ast.Expr {
+	namedTypes := map[string]ast.Expr{}
+
+	for _, file := range files {
+		for _, d := range file.Decls {
+			genDecl := typeutil.AsOrEmpty[*ast.GenDecl](d)
+			if genDecl == nil || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, s := range genDecl.Specs {
+				typeSpec := typeutil.AsOrEmpty[*ast.TypeSpec](s)
+				if typeSpec == nil || typeSpec.TypeParams != nil {
+					continue
+				}
+
+				if _, ok := typeSpec.Type.(*ast.StructType); !ok {
+					namedTypes[typeSpec.Name.Name] = typeSpec.Type
+				}
+			}
+		}
+	}
+
+	return namedTypes
+}
+
+// isRecursiveType reports whether the underlying type of the named type refers to the type itself,
+// such as type Tree []Tree.
+func (g *Generator) isRecursiveType(name string) bool {
+	visited := map[string]bool{}
+
+	var refersTo func(typeName string) bool
+
+	refersTo = func(typeName string) bool {
+		if visited[typeName] {
+			return false
+		}
+
+		visited[typeName] = true
+
+		found := false
+
+		ast.Inspect(g.namedTypes[typeName], func(node ast.Node) bool {
+			ident := typeutil.AsOrEmpty[*ast.Ident](node)
+			if ident == nil || found {
+				return !found
+			}
+
+			if _, ok := g.namedTypes[ident.Name]; ok {
+				found = ident.Name == name || refersTo(ident.Name)
+			}
+
+			return !found
+		})
+
+		return found
+	}
+
+	return refersTo(name)
+}
+
+// buildCollectionEqualExpr returns pkg.Equal(x, y) when the elements can be compared with ==,
+// and pkg.EqualFunc(x, y, func(a, b T) bool { ... }) otherwise.
+func (g *Generator) buildCollectionEqualExpr(pkg string, x ast.Expr, y ast.Expr, elemType ast.Expr) (ast.Expr, error) {
+	a := astutil.NewIdent("a")
+	b := astutil.NewIdent("b")
+
+	elemEqualExpr, err := g.buildEqualExpr(a, b, elemType)
+	if err != nil {
+		return nil, err
+	}
+
+	if binaryExpr := typeutil.AsOrEmpty[*ast.BinaryExpr](elemEqualExpr); binaryExpr != nil &&
+		binaryExpr.Op == token.EQL && binaryExpr.X == a && binaryExpr.Y == b {
+		return &ast.CallExpr{
+			Fun:  astutil.NewSelectorExpr(astutil.NewIdent(pkg), astutil.NewIdent("Equal")),
+			Args: []ast.Expr{x, y},
+		}, nil
+	}
+
+	return &ast.CallExpr{
+		Fun: astutil.NewSelectorExpr(astutil.NewIdent(pkg), astutil.NewIdent("EqualFunc")),
+		Args: []ast.Expr{
+			x,
+			y,
+			&ast.FuncLit{
+				Type: astutil.NewFuncType(
+					nil,
+					astutil.NewFieldList(
+						[]*ast.Field{
+							astutil.NewField([]*ast.Ident{astutil.NewIdent("a"), astutil.NewIdent("b")}, elemType),
+						},
+					),
+					astutil.NewFieldList(
+						[]*ast.Field{
+							astutil.NewField(nil, astutil.NewIdent("bool")),
+						},
+					),
+				),
+				Body: astutil.NewBlockStmt(
+					[]ast.Stmt{
+						astutil.NewReturnStmt([]ast.Expr{elemEqualExpr}),
+					},
+				),
+			},
+		},
+	}, nil
+}
+
+// selectorOf returns x.name, with parentheses around a dereferenced x.
+func selectorOf(x ast.Expr, name string) ast.Expr {
+	if _, ok := x.(*ast.StarExpr); ok {
+		x = &ast.ParenExpr{X: x}
+	}
+
+	return astutil.NewSelectorExpr(x, astutil.NewIdent(name))
+}
+
+// sliceOf returns x[:], with parentheses around a dereferenced x.
+func sliceOf(x ast.Expr) ast.Expr {
+	if _, ok := x.(*ast.StarExpr); ok {
+		x = &ast.ParenExpr{X: x}
+	}
+
+	return &ast.SliceExpr{X: x}
+}
+
+func isFuncCallOf(expr ast.Expr, pkg string, name string) bool {
+	callExpr := typeutil.AsOrEmpty[*ast.CallExpr](expr)
+	if callExpr == nil {
+		return false
+	}
+
+	selectorExpr := typeutil.AsOrEmpty[*ast.SelectorExpr](callExpr.Fun)
+
+	return selectorExpr != nil && isIdentType(selectorExpr.X, pkg) && selectorExpr.Sel.Name == name
+}
+
+func (g *Generator) compareFuncDecls(structName string, fieldList *ast.FieldList, keys []string) ([]ast.Decl, error) {
+	if len(keys) < 1 {
+		return nil, errors.Wrapf(errInvalidTypeDirective, "compare requires key fields: type=%s", structName)
+	}
+
+	fields := map[string]*ast.Field{}
+
+	for _, field := range fieldList.List {
+		for _, name := range field.Names {
+			fields[name.Name] = field
+		}
+	}
+
+	var compareExprs []ast.Expr
+
+	for _, key := range keys {
+		field, ok := fields[key]
+		if !ok {
+			return nil, errors.Wrapf(errInvalidTypeDirective, "compare key not found: type=%s field=%s", structName, key)
+		}
+
+		compareExpr, err := g.buildCompareExpr(key, field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		compareExprs = append(compareExprs, compareExpr)
+	}
+
+	var stmts []ast.Stmt
+
+	for _, compareExpr := range compareExprs[:len(compareExprs)-1] {
+		stmts = append(stmts, &ast.IfStmt{
+			Init: astutil.NewAssignStmt(
+				[]ast.Expr{astutil.NewIdent("c")},
+				token.DEFINE,
+				[]ast.Expr{compareExpr},
+			),
+			Cond: &ast.BinaryExpr{Op: token.NEQ, X: astutil.NewIdent("c"), Y: astutil.NewBasicLit(token.INT, "0")},
+			Body: astutil.NewBlockStmt(
+				[]ast.Stmt{
+					astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("c")}),
+				},
+			),
+		})
+	}
+
+	stmts = append(stmts, astutil.NewReturnStmt(compareExprs[len(compareExprs)-1:]))
+
+	return []ast.Decl{
+		&ast.FuncDecl{
+			Recv: g.buildRecvFieldList(structName),
+			Name: astutil.NewIdent("Compare"),
+			Type: g.buildOtherFuncType(structName, astutil.NewIdent("int")),
+			Body: astutil.NewBlockStmt(stmts),
+		},
+		&ast.FuncDecl{
+			Recv: g.buildRecvFieldList(structName),
+			Name: astutil.NewIdent("Less"),
+			Type: g.buildOtherFuncType(structName, astutil.NewIdent("bool")),
+			Body: astutil.NewBlockStmt(
+				[]ast.Stmt{
+					astutil.NewReturnStmt(
+						[]ast.Expr{
+							&ast.BinaryExpr{
+								Op: token.LSS,
+								X: &ast.CallExpr{
+									Fun:  astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent("Compare")),
+									Args: []ast.Expr{astutil.NewIdent("other")},
+								},
+								Y: astutil.NewBasicLit(token.INT, "0"),
+							},
+						},
+					),
+				},
+			),
+		},
+	}, nil
+}
+
+func (g *Generator) buildCompareExpr(name string, fieldType ast.Expr) (ast.Expr, error) {
+	x := astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(name))
+	y := astutil.NewSelectorExpr(astutil.NewIdent("other"), astutil.NewIdent(name))
+
+	switch {
+	case isIdentType(fieldType, "string") || isNumericType(fieldType):
+		return &ast.CallExpr{
+			Fun:  astutil.NewSelectorExpr(astutil.NewIdent("cmp"), astutil.NewIdent("Compare")),
+			Args: []ast.Expr{x, y},
+		}, nil
+
+	case isTimeType(fieldType):
+		return &ast.CallExpr{
+			Fun:  astutil.NewSelectorExpr(x, astutil.NewIdent("Compare")),
+			Args: []ast.Expr{y},
+		}, nil
+
+	default:
+		return nil, errors.Wrapf(errInvalidFieldType, "compare key must be ordered: field=%s", name)
+	}
+}
+
+func (g *Generator) buildOtherFuncType(structName string, resultType ast.Expr) *ast.FuncType {
+	return astutil.NewFuncType(
+		nil,
+		astutil.NewFieldList(
+			[]*ast.Field{
+				astutil.NewField(
+					[]*ast.Ident{
+						astutil.NewIdent("other"),
+					},
+					astutil.NewStarExpr(astutil.NewIdent(structName)),
+				),
+			},
+		),
+		astutil.NewFieldList(
+			[]*ast.Field{
+				astutil.NewField(nil, resultType),
+			},
+		),
+	)
+}
@@ -0,0 +1,233 @@
+package generator
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildNotEqualExpr(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+	generator.equalTypes = map[string]bool{"Address": true}
+	generator.namedTypes = map[string]ast.Expr{
+		"ID":      &ast.Ident{Name: "string"},
+		"Tags":    &ast.ArrayType{Elt: &ast.Ident{Name: "string"}},
+		"Matrix":  &ast.ArrayType{Elt: &ast.Ident{Name: "Tags"}},
+		"Handler": &ast.FuncType{},
+		"Tree":    &ast.ArrayType{Elt: &ast.Ident{Name: "Node"}},
+		"Node":    &ast.MapType{Key: &ast.Ident{Name: "string"}, Value: &ast.Ident{Name: "Tree"}},
+	}
+
+	tests := []struct {
+		name      string
+		fieldType ast.Expr
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "success: comparable type",
+			fieldType: &ast.Ident{Name: "string"},
+			want:      "t.value != other.value",
+		},
+		{
+			name:      "success: byte slice",
+			fieldType: &ast.ArrayType{Elt: &ast.Ident{Name: "byte"}},
+			want:      "!bytes.Equal(t.value, other.value)",
+		},
+		{
+			name:      "success: slice",
+			fieldType: &ast.ArrayType{Elt: &ast.Ident{Name: "int"}},
+			want:      "!slices.Equal(t.value, other.value)",
+		},
+		{
+			name:      "success: array",
+			fieldType: &ast.ArrayType{Len: &ast.BasicLit{Kind: token.INT, Value: "4"}, Elt: &ast.Ident{Name: "byte"}},
+			want:      "t.value != other.value",
+		},
+		{
+			name:      "success: map",
+			fieldType: &ast.MapType{Key: &ast.Ident{Name: "string"}, Value: &ast.Ident{Name: "int"}},
+			want:      "!maps.Equal(t.value, other.value)",
+		},
+		{
+			name:      "success: time",
+			fieldType: &ast.SelectorExpr{X: &ast.Ident{Name: "time"}, Sel: &ast.Ident{Name: "Time"}},
+			want:      "!t.value.Equal(other.value)",
+		},
+		{
+			name:      "success: type with equal directive",
+			fieldType: &ast.Ident{Name: "Address"},
+			want:      "!t.value.Equal(&other.value)",
+		},
+		{
+			name:      "success: pointer to type with equal directive",
+			fieldType: &ast.StarExpr{X: &ast.Ident{Name: "Address"}},
+			want:      "!t.value.Equal(other.value)",
+		},
+		{
+			name:      "success: pointer to other type",
+			fieldType: &ast.StarExpr{X: &ast.Ident{Name: "Other"}},
+			want:      "!(t.value == other.value || t.value != nil && other.value != nil && *t.value == *other.value)",
+		},
+		{
+			name:      "success: pointer to time",
+			fieldType: &ast.StarExpr{X: &ast.SelectorExpr{X: &ast.Ident{Name: "time"}, Sel: &ast.Ident{Name: "Time"}}},
+			want:      "!(t.value == other.value || t.value != nil && other.value != nil && (*t.value).Equal(*other.value))",
+		},
+		{
+			name:      "success: nested slice",
+			fieldType: &ast.ArrayType{Elt: &ast.ArrayType{Elt: &ast.Ident{Name: "string"}}},
+			want:      "!slices.EqualFunc(t.value, other.value, func(a, b []string) bool {\n\treturn slices.Equal(a, b)\n})",
+		},
+		{
+			name:      "success: map of slices",
+			fieldType: &ast.MapType{Key: &ast.Ident{Name: "string"}, Value: &ast.ArrayType{Elt: &ast.Ident{Name: "int"}}},
+			want:      "!maps.EqualFunc(t.value, other.value, func(a, b []int) bool {\n\treturn slices.Equal(a, b)\n})",
+		},
+		{
+			name:      "success: slice of types with equal directive",
+			fieldType: &ast.ArrayType{Elt: &ast.Ident{Name: "Address"}},
+			want:      "!slices.EqualFunc(t.value, other.value, func(a, b Address) bool {\n\treturn a.Equal(&b)\n})",
+		},
+		{
+			name:      "success: array of slices",
+			fieldType: &ast.ArrayType{Len: &ast.BasicLit{Kind: token.INT, Value: "2"}, Elt: &ast.ArrayType{Elt: &ast.Ident{Name: "int"}}},
+			want:      "!slices.EqualFunc(t.value[:], other.value[:], func(a, b []int) bool {\n\treturn slices.Equal(a, b)\n})",
+		},
+		{
+			name:      "success: named comparable type",
+			fieldType: &ast.Ident{Name: "ID"},
+			want:      "t.value != other.value",
+		},
+		{
+			name:      "success: named slice",
+			fieldType: &ast.Ident{Name: "Tags"},
+			want:      "!slices.Equal(t.value, other.value)",
+		},
+		{
+			name:      "success: named slice of named slices",
+			fieldType: &ast.Ident{Name: "Matrix"},
+			want:      "!slices.EqualFunc(t.value, other.value, func(a, b Tags) bool {\n\treturn slices.Equal(a, b)\n})",
+		},
+		{
+			name:      "failure: func",
+			fieldType: &ast.FuncType{},
+			wantErr:   true,
+		},
+		{
+			name:      "failure: slice of funcs",
+			fieldType: &ast.ArrayType{Elt: &ast.FuncType{}},
+			wantErr:   true,
+		},
+		{
+			name:      "failure: named func",
+			fieldType: &ast.Ident{Name: "Handler"},
+			wantErr:   true,
+		},
+		{
+			name:      "failure: recursive named type",
+			fieldType: &ast.Ident{Name: "Tree"},
+			wantErr:   true,
+		},
+		{
+			name:      "failure: anonymous struct",
+			fieldType: &ast.StructType{Fields: &ast.FieldList{}},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			field := &ast.Field{
+				Names: []*ast.Ident{{Name: "value"}},
+				Type:  tt.fieldType,
+			}
+
+			got, err := generator.buildNotEqualExpr(field)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errInvalidFieldType)
+				return
+			}
+
+			require.NoError(t, err)
+
+			buffer := bytes.NewBuffer([]byte{})
+			require.NoError(t, format.Node(buffer, token.NewFileSet(), got))
+			assert.Equal(t, tt.want, buffer.String())
+		})
+	}
+}
+
+func TestCompareFuncDecls(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	fieldList := &ast.FieldList{
+		List: []*ast.Field{
+			{
+				Names: []*ast.Ident{{Name: "name"}},
+				Type:  &ast.Ident{Name: "string"},
+			},
+			{
+				Names: []*ast.Ident{{Name: "active"}},
+				Type:  &ast.Ident{Name: "bool"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		keys    []string
+		wantErr error
+	}{
+		{
+			name: "success: ordered key",
+			keys: []string{"name"},
+		},
+		{
+			name:    "failure: no keys",
+			wantErr: errInvalidTypeDirective,
+		},
+		{
+			name:    "failure: unknown key",
+			keys:    []string{"unknown"},
+			wantErr: errInvalidTypeDirective,
+		},
+		{
+			name:    "failure: unordered key",
+			keys:    []string{"active"},
+			wantErr: errInvalidFieldType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decls, err := generator.compareFuncDecls("TestStruct", fieldList, tt.keys)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, decls, 2)
+		})
+	}
+}
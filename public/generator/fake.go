@@ -0,0 +1,169 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/hidori/go-astutil"
+	"github.com/hidori/go-typeutil"
+	"github.com/pkg/errors"
+)
+
+// GenerateFakes generates in-memory fakes of the interfaces generated for structs with the interface directive.
+// Fakes use the receiver name of the methods generated for the struct.
+func (g *Generator) GenerateFakes(fileSet *token.FileSet, file *ast.File) ([]ast.Decl, error) {
+	var decls []ast.Decl
+
+	if g.config.Receiver != "" && !isReceiverName(g.config.Receiver) {
+		return nil, errors.Wrapf(errInvalidReceiverName, "receiver=%s", g.config.Receiver)
+	}
+
+	gen := &Generator{
+		config:    g.config,
+		receivers: receiversOf(append([]*ast.File{file}, g.config.PackageFiles...)...),
+	}
+
+	for _, d := range file.Decls {
+		genDecl := typeutil.AsOrEmpty[*ast.GenDecl](d)
+
+		if genDecl == nil {
+			continue
+		}
+
+		if genDecl.Tok == token.IMPORT {
+			decls = append(decls, genDecl)
+
+			continue
+		}
+
+		if genDecl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, s := range genDecl.Specs {
+			typeSpec := typeutil.AsOrEmpty[*ast.TypeSpec](s)
+			if typeSpec == nil {
+				continue
+			}
+
+			structType := typeutil.AsOrEmpty[*ast.StructType](typeSpec.Type)
+			if structType == nil {
+				continue
+			}
+
+			directives, err := g.parseTypeDirectives(typeSpecDoc(genDecl, typeSpec))
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+
+			if !directives.enabled("interface") {
+				continue
+			}
+
+			typeGen, err := gen.forType(typeSpec.Name.Name, structType.Fields, directives)
+			if err != nil {
+				return nil, err
+			}
+
+			fakeDecls := typeGen.fakeDecls(typeSpec.Name.Name, structType.Fields)
+			typeGen.renameLocals(fakeDecls)
+
+			decls = append(decls, fakeDecls...)
+		}
+	}
+
+	return decls, nil
+}
+
+func (g *Generator) fakeDecls(structName string, fieldList *ast.FieldList) []ast.Decl {
+	readerMethods, writerMethods := g.interfaceMethods(structName, fieldList)
+
+	var decls []ast.Decl
+
+	if len(readerMethods) > 0 {
+		decls = append(decls, g.buildFakeDecls(structName+"Reader", readerMethods)...)
+	}
+
+	if len(writerMethods) > 0 {
+		decls = append(decls, g.buildFakeDecls(structName+"Writer", writerMethods)...)
+	}
+
+	return decls
+}
+
+func (g *Generator) buildFakeDecls(interfaceName string, methods []*ast.Field) []ast.Decl {
+	fakeName := "Fake" + interfaceName
+
+	var fields []*ast.Field
+
+	var funcDecls []ast.Decl
+
+	for _, method := range methods {
+		name := method.Names[0].Name
+		funcType := typeutil.AsOrEmpty[*ast.FuncType](method.Type)
+
+		callsName := name + "Calls"
+		fields = append(fields, astutil.NewField([]*ast.Ident{astutil.NewIdent(callsName)}, astutil.NewIdent("int")))
+
+		stmts := []ast.Stmt{
+			&ast.IncDecStmt{
+				X:   astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(callsName)),
+				Tok: token.INC,
+			},
+		}
+
+		if funcType.Params != nil && len(funcType.Params.List) == 1 && len(funcType.Params.List[0].Names) == 1 {
+			param := funcType.Params.List[0]
+			argsName := name + "Args"
+			argsExpr := astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(argsName))
+
+			fields = append(fields, astutil.NewField([]*ast.Ident{astutil.NewIdent(argsName)}, &ast.ArrayType{Elt: param.Type}))
+			stmts = append(stmts, astutil.NewAssignStmt(
+				[]ast.Expr{argsExpr},
+				token.ASSIGN,
+				[]ast.Expr{
+					&ast.CallExpr{
+						Fun:  astutil.NewIdent("append"),
+						Args: []ast.Expr{argsExpr, astutil.NewIdent(param.Names[0].Name)},
+					},
+				},
+			))
+		}
+
+		if funcType.Results != nil && len(funcType.Results.List) == 1 {
+			returnsName := name + "Returns"
+
+			fields = append(fields, astutil.NewField([]*ast.Ident{astutil.NewIdent(returnsName)}, funcType.Results.List[0].Type))
+			stmts = append(stmts, astutil.NewReturnStmt(
+				[]ast.Expr{
+					astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(returnsName)),
+				},
+			))
+		}
+
+		funcDecls = append(funcDecls, &ast.FuncDecl{
+			Recv: g.buildRecvFieldList(fakeName),
+			Name: astutil.NewIdent(name),
+			Type: funcType,
+			Body: astutil.NewBlockStmt(stmts),
+		})
+	}
+
+	decls := []ast.Decl{
+		&ast.GenDecl{
+			Tok: token.TYPE,
+			Specs: []ast.Spec{
+				&ast.TypeSpec{
+					Name: astutil.NewIdent(fakeName),
+					Type: &ast.StructType{
+						Fields: astutil.NewFieldList(fields),
+					},
+				},
+			},
+		},
+	}
+
+	decls = append(decls, funcDecls...)
+
+	return append(decls, g.buildAssertionDecl(interfaceName, fakeName))
+}
@@ -0,0 +1,75 @@
+package generator
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator_GenerateFakes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		inputFileName  string
+		outputFileName string
+		config         *GeneratorConfig
+		wantErr        bool
+	}{
+		{
+			name:           "success: returns fakes",
+			inputFileName:  "./testdata/interface_input.go.txt",
+			outputFileName: "./testdata/interface_fakes_output.txt",
+			config: &GeneratorConfig{
+				TagName:        tagName,
+				Initialism:     []string{"api"},
+				ValidationFunc: "validateFieldValue",
+				ValidationTag:  "validate",
+			},
+		},
+		{
+			name:           "success: returns fakes with receiver of existing methods",
+			inputFileName:  "./testdata/interface_fakes_receiver_input.go.txt",
+			outputFileName: "./testdata/interface_fakes_receiver_output.txt",
+			config: &GeneratorConfig{
+				TagName:    tagName,
+				Initialism: []string{"api"},
+			},
+		},
+		{
+			name:          "failure: invalid receiver name",
+			inputFileName: "./testdata/interface_input.go.txt",
+			config: &GeneratorConfig{
+				TagName:  tagName,
+				Receiver: "json",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := parser.ParseFile(token.NewFileSet(), tt.inputFileName, nil, parser.AllErrors|parser.ParseComments)
+			require.NoError(t, err)
+
+			got, err := NewGenerator(tt.config).GenerateFakes(token.NewFileSet(), f)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errInvalidReceiverName)
+				return
+			}
+
+			require.NoError(t, err)
+
+			want, err := parser.ParseFile(token.NewFileSet(), tt.outputFileName, nil, parser.AllErrors|parser.ParseComments)
+			require.NoError(t, err)
+
+			assert.Equal(t, formatDecls(t, want.Decls), formatDecls(t, got))
+		})
+	}
+}
@@ -0,0 +1,314 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"github.com/hidori/go-astutil"
+)
+
+func (g *Generator) fieldsDecls(structName string, fieldList *ast.FieldList) []ast.Decl {
+	fields := g.propertyFields(fieldList, nil)
+	descriptorName := structName + "FieldDescriptor"
+
+	return []ast.Decl{
+		g.fieldNameConstDecl(structName, fields),
+		g.fieldDescriptorTypeDecl(descriptorName),
+		&ast.GenDecl{
+			Tok: token.VAR,
+			Specs: []ast.Spec{
+				&ast.ValueSpec{
+					Names: []*ast.Ident{
+						astutil.NewIdent(structName + "Fields"),
+					},
+					Values: []ast.Expr{
+						&ast.CallExpr{
+							Fun: astutil.NewIdent("new" + structName + "Fields"),
+						},
+					},
+				},
+			},
+		},
+		g.newFieldsFuncDecl(structName, descriptorName, fields),
+		g.getFieldFuncDecl(structName, fields),
+		g.setFieldFuncDecl(structName, fields),
+	}
+}
+
+func (g *Generator) fieldNameConstOf(structName string, field *ast.Field) string {
+	return structName + "Field" + g.prepareFieldName(field.Names[0].Name)
+}
+
+func (g *Generator) fieldNameConstDecl(structName string, fields []*ast.Field) ast.Decl {
+	var specs []ast.Spec
+
+	for _, field := range fields {
+		specs = append(specs, &ast.ValueSpec{
+			Names: []*ast.Ident{
+				astutil.NewIdent(g.fieldNameConstOf(structName, field)),
+			},
+			Values: []ast.Expr{
+				astutil.NewBasicLit(token.STRING, strconv.Quote(field.Names[0].Name)),
+			},
+		})
+	}
+
+	return &ast.GenDecl{
+		Tok:    token.CONST,
+		Lparen: token.Pos(1),
+		Specs:  specs,
+	}
+}
+
+func (g *Generator) fieldDescriptorTypeDecl(descriptorName string) ast.Decl {
+	newField := func(name string, fieldType string) *ast.Field {
+		return astutil.NewField([]*ast.Ident{astutil.NewIdent(name)}, astutil.NewIdent(fieldType))
+	}
+
+	return &ast.GenDecl{
+		Tok: token.TYPE,
+		Specs: []ast.Spec{
+			&ast.TypeSpec{
+				Name: astutil.NewIdent(descriptorName),
+				Type: &ast.StructType{
+					Fields: astutil.NewFieldList(
+						[]*ast.Field{
+							newField("Name", "string"),
+							newField("Type", "string"),
+							newField("Tag", "string"),
+							newField("Readable", "bool"),
+							newField("Writable", "bool"),
+						},
+					),
+				},
+			},
+		},
+	}
+}
+
+func (g *Generator) newFieldsFuncDecl(structName string, descriptorName string, fields []*ast.Field) ast.Decl {
+	sliceType := &ast.ArrayType{Elt: astutil.NewIdent(descriptorName)}
+
+	stmts := []ast.Stmt{
+		astutil.NewAssignStmt(
+			[]ast.Expr{astutil.NewIdent("fields")},
+			token.DEFINE,
+			[]ast.Expr{
+				&ast.CallExpr{
+					Fun: astutil.NewIdent("make"),
+					Args: []ast.Expr{
+						sliceType,
+						astutil.NewBasicLit(token.INT, "0"),
+						astutil.NewBasicLit(token.INT, strconv.Itoa(len(fields))),
+					},
+				},
+			},
+		),
+	}
+
+	for _, field := range fields {
+		_, readable := g.getterNameOf(field)
+		writable := g.hasDirective(field, "set")
+
+		tag := astutil.NewBasicLit(token.STRING, `""`)
+		if field.Tag != nil {
+			tag = astutil.NewBasicLit(token.STRING, field.Tag.Value)
+		}
+
+		stmts = append(stmts, astutil.NewAssignStmt(
+			[]ast.Expr{astutil.NewIdent("fields")},
+			token.ASSIGN,
+			[]ast.Expr{
+				&ast.CallExpr{
+					Fun: astutil.NewIdent("append"),
+					Args: []ast.Expr{
+						astutil.NewIdent("fields"),
+						&ast.CompositeLit{
+							Type: astutil.NewIdent(descriptorName),
+							Elts: []ast.Expr{
+								&ast.KeyValueExpr{Key: astutil.NewIdent("Name"), Value: astutil.NewIdent(g.fieldNameConstOf(structName, field))},
+								&ast.KeyValueExpr{Key: astutil.NewIdent("Type"), Value: astutil.NewBasicLit(token.STRING, strconv.Quote(types.ExprString(field.Type)))},
+								&ast.KeyValueExpr{Key: astutil.NewIdent("Tag"), Value: tag},
+								&ast.KeyValueExpr{Key: astutil.NewIdent("Readable"), Value: astutil.NewIdent(strconv.FormatBool(readable))},
+								&ast.KeyValueExpr{Key: astutil.NewIdent("Writable"), Value: astutil.NewIdent(strconv.FormatBool(writable))},
+							},
+						},
+					},
+				},
+			},
+		))
+	}
+
+	stmts = append(stmts, astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("fields")}))
+
+	return &ast.FuncDecl{
+		Name: astutil.NewIdent("new" + structName + "Fields"),
+		Type: astutil.NewFuncType(
+			nil,
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(nil, sliceType),
+				},
+			),
+		),
+		Body: astutil.NewBlockStmt(stmts),
+	}
+}
+
+func (g *Generator) getFieldFuncDecl(structName string, fields []*ast.Field) ast.Decl {
+	var clauses []ast.Stmt
+
+	for _, field := range fields {
+		getterName, ok := g.getterNameOf(field)
+		if !ok {
+			continue
+		}
+
+		clauses = append(clauses, &ast.CaseClause{
+			List: []ast.Expr{astutil.NewIdent(g.fieldNameConstOf(structName, field))},
+			Body: []ast.Stmt{
+				astutil.NewReturnStmt(
+					[]ast.Expr{
+						&ast.CallExpr{
+							Fun: astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(getterName)),
+						},
+						astutil.NewIdent("true"),
+					},
+				),
+			},
+		})
+	}
+
+	clauses = append(clauses, &ast.CaseClause{
+		Body: []ast.Stmt{
+			astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("nil"), astutil.NewIdent("false")}),
+		},
+	})
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("GetField"),
+		Type: astutil.NewFuncType(
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField([]*ast.Ident{astutil.NewIdent("name")}, astutil.NewIdent("string")),
+				},
+			),
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(nil, astutil.NewIdent("any")),
+					astutil.NewField(nil, astutil.NewIdent("bool")),
+				},
+			),
+		),
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				&ast.SwitchStmt{
+					Tag:  astutil.NewIdent("name"),
+					Body: astutil.NewBlockStmt(clauses),
+				},
+			},
+		),
+	}
+}
+
+func (g *Generator) setFieldFuncDecl(structName string, fields []*ast.Field) ast.Decl {
+	var clauses []ast.Stmt
+
+	for _, field := range fields {
+		if !g.hasDirective(field, "set") {
+			continue
+		}
+
+		setterName, _ := g.setterNameOf(field)
+
+		callExpr := &ast.CallExpr{
+			Fun:  astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(setterName)),
+			Args: []ast.Expr{astutil.NewIdent("value")},
+		}
+
+		body := []ast.Stmt{
+			astutil.NewAssignStmt(
+				[]ast.Expr{astutil.NewIdent("value"), astutil.NewIdent("ok")},
+				token.DEFINE,
+				[]ast.Expr{
+					&ast.TypeAssertExpr{X: astutil.NewIdent("v"), Type: field.Type},
+				},
+			),
+			&ast.IfStmt{
+				Cond: &ast.UnaryExpr{Op: token.NOT, X: astutil.NewIdent("ok")},
+				Body: astutil.NewBlockStmt(
+					[]ast.Stmt{
+						astutil.NewReturnStmt(
+							[]ast.Expr{
+								g.buildErrorfExpr("field %s: unexpected type %T", astutil.NewIdent("name"), astutil.NewIdent("v")),
+							},
+						),
+					},
+				),
+			},
+		}
+
+		if g.hasValidation(field) {
+			body = append(body, astutil.NewReturnStmt([]ast.Expr{callExpr}))
+		} else {
+			body = append(body,
+				&ast.ExprStmt{X: callExpr},
+				astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("nil")}),
+			)
+		}
+
+		clauses = append(clauses, &ast.CaseClause{
+			List: []ast.Expr{astutil.NewIdent(g.fieldNameConstOf(structName, field))},
+			Body: body,
+		})
+	}
+
+	clauses = append(clauses, &ast.CaseClause{
+		Body: []ast.Stmt{
+			astutil.NewReturnStmt(
+				[]ast.Expr{
+					g.buildErrorfExpr("field %s is not writable", astutil.NewIdent("name")),
+				},
+			),
+		},
+	})
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("SetField"),
+		Type: astutil.NewFuncType(
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField([]*ast.Ident{astutil.NewIdent("name")}, astutil.NewIdent("string")),
+					astutil.NewField([]*ast.Ident{astutil.NewIdent("v")}, astutil.NewIdent("any")),
+				},
+			),
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(nil, astutil.NewIdent("error")),
+				},
+			),
+		),
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				&ast.SwitchStmt{
+					Tag:  astutil.NewIdent("name"),
+					Body: astutil.NewBlockStmt(clauses),
+				},
+			},
+		),
+	}
+}
+
+func (g *Generator) buildErrorfExpr(format string, args ...ast.Expr) ast.Expr {
+	return &ast.CallExpr{
+		Fun:  astutil.NewSelectorExpr(astutil.NewIdent("fmt"), astutil.NewIdent("Errorf")),
+		Args: append([]ast.Expr{astutil.NewBasicLit(token.STRING, strconv.Quote(format))}, args...),
+	}
+}
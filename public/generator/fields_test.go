@@ -0,0 +1,90 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldsDecls(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName:    tagName,
+		Initialism: []string{"id"},
+	})
+
+	tests := []struct {
+		name          string
+		tag           string
+		wantConst     string
+		wantGetCases  int
+		wantSetCases  int
+		wantReadable  string
+		wantWriteable string
+	}{
+		{
+			name:          "success: getter and setter",
+			tag:           "`property:\"get,set\"`",
+			wantConst:     "TestStructFieldID",
+			wantGetCases:  2,
+			wantSetCases:  2,
+			wantReadable:  "true",
+			wantWriteable: "true",
+		},
+		{
+			name:          "success: private setter is not writable",
+			tag:           "`property:\"is,set=private\"`",
+			wantConst:     "TestStructFieldID",
+			wantGetCases:  2,
+			wantSetCases:  1,
+			wantReadable:  "true",
+			wantWriteable: "false",
+		},
+		{
+			name:          "success: setter only is not readable",
+			tag:           "`property:\"set\"`",
+			wantConst:     "TestStructFieldID",
+			wantGetCases:  1,
+			wantSetCases:  2,
+			wantReadable:  "false",
+			wantWriteable: "true",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fieldList := &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{{Name: "id"}},
+						Type:  &ast.Ident{Name: "bool"},
+						Tag:   &ast.BasicLit{Value: tt.tag},
+					},
+				},
+			}
+
+			decls := generator.fieldsDecls("TestStruct", fieldList)
+			require.Len(t, decls, 6)
+
+			constSpec := decls[0].(*ast.GenDecl).Specs[0].(*ast.ValueSpec)
+			assert.Equal(t, tt.wantConst, constSpec.Names[0].Name)
+
+			newFields := decls[3].(*ast.FuncDecl)
+			appendCall := newFields.Body.List[1].(*ast.AssignStmt).Rhs[0].(*ast.CallExpr)
+			descriptor := appendCall.Args[1].(*ast.CompositeLit)
+			assert.Equal(t, tt.wantReadable, descriptor.Elts[3].(*ast.KeyValueExpr).Value.(*ast.Ident).Name)
+			assert.Equal(t, tt.wantWriteable, descriptor.Elts[4].(*ast.KeyValueExpr).Value.(*ast.Ident).Name)
+
+			getField := decls[4].(*ast.FuncDecl)
+			assert.Len(t, getField.Body.List[0].(*ast.SwitchStmt).Body.List, tt.wantGetCases)
+
+			setField := decls[5].(*ast.FuncDecl)
+			assert.Len(t, setField.Body.List[0].(*ast.SwitchStmt).Body.List, tt.wantSetCases)
+		})
+	}
+}
@@ -17,16 +17,31 @@ import (
 )
 
 // GeneratorConfig holds configuration for the code generator.
+// FallbackTagNames are read in order when a field has no TagName tag, and Warn, when set, receives warnings.
+// PackageFiles are the other files of the package, which are read for the receiver names of existing methods.
 type GeneratorConfig struct {
-	TagName        string
-	Initialism     []string
-	ValidationFunc string
-	ValidationTag  string
+	TagName          string
+	FallbackTagNames []string
+	Initialism       []string
+	ValidationFunc   string
+	ValidationTag    string
+	NilSafe          bool
+	Receiver         string
+	PackageFiles     []*ast.File
+	Warn             func(message string)
 }
 
 // Generator generates getter and setter methods for struct fields.
 type Generator struct {
-	config *GeneratorConfig
+	config       *GeneratorConfig
+	equalTypes   map[string]bool
+	cloneTypes   map[string]bool
+	receivers    map[string]string
+	structTypes  map[string]*ast.StructType
+	namedTypes   map[string]ast.Expr
+	receiver     string
+	recvIdents   map[*ast.Ident]bool
+	valueGetters bool
 }
 
 // NewGenerator creates a new Generator with the given configuration.
@@ -40,6 +55,21 @@ func NewGenerator(config *GeneratorConfig) *Generator {
 func (g *Generator) Generate(fileSet *token.FileSet, file *ast.File) ([]ast.Decl, error) {
 	var decls []ast.Decl
 
+	if g.config.Receiver != "" && !isReceiverName(g.config.Receiver) {
+		return nil, errors.Wrapf(errInvalidReceiverName, "receiver=%s", g.config.Receiver)
+	}
+
+	files := append([]*ast.File{file}, g.config.PackageFiles...)
+
+	gen := &Generator{
+		config:      g.config,
+		equalTypes:  g.typesWithDirective(file, "equal"),
+		cloneTypes:  g.typesWithDirective(file, "clone"),
+		receivers:   receiversOf(files...),
+		structTypes: structTypesOf(files...),
+		namedTypes:  namedTypesOf(files...),
+	}
+
 	for _, d := range file.Decls {
 		genDecl := typeutil.AsOrEmpty[*ast.GenDecl](d)
 
@@ -47,7 +77,7 @@ func (g *Generator) Generate(fileSet *token.FileSet, file *ast.File) ([]ast.Decl
 			continue
 		}
 
-		_decls, err := g.fromGenDecl(genDecl)
+		_decls, err := gen.fromGenDecl(genDecl)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
@@ -55,9 +85,38 @@ func (g *Generator) Generate(fileSet *token.FileSet, file *ast.File) ([]ast.Decl
 		decls = append(decls, _decls...)
 	}
 
+	if len(gen.typesWithDirective(file, "diff")) > 0 && !declaresType(fieldChangeName, files...) {
+		decls = append(decls, fieldChangeDecl())
+	}
+
 	return decls, nil
 }
 
+func (g *Generator) typesWithDirective(file *ast.File, name string) map[string]bool {
+	types := map[string]bool{}
+
+	for _, d := range file.Decls {
+		genDecl := typeutil.AsOrEmpty[*ast.GenDecl](d)
+		if genDecl == nil || genDecl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, s := range genDecl.Specs {
+			typeSpec := typeutil.AsOrEmpty[*ast.TypeSpec](s)
+			if typeSpec == nil {
+				continue
+			}
+
+			directives, err := g.parseTypeDirectives(typeSpecDoc(genDecl, typeSpec))
+			if err == nil && directives.enabled(name) {
+				types[typeSpec.Name.Name] = true
+			}
+		}
+	}
+
+	return types
+}
+
 //nolint:exhaustive // Only IMPORT/TYPE tokens are processed, others handled by default case
 func (g *Generator) fromGenDecl(genDecl *ast.GenDecl) ([]ast.Decl, error) {
 	switch genDecl.Tok {
@@ -82,7 +141,7 @@ func (g *Generator) fromTypeGenDecl(genDecl *ast.GenDecl) ([]ast.Decl, error) {
 			continue
 		}
 
-		_decls, err := g.fromTypeSpec(typeSpec)
+		_decls, err := g.fromTypeSpec(typeSpec, typeSpecDoc(genDecl, typeSpec))
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
@@ -93,14 +152,164 @@ func (g *Generator) fromTypeGenDecl(genDecl *ast.GenDecl) ([]ast.Decl, error) {
 	return decls, nil
 }
 
-func (g *Generator) fromTypeSpec(typeSpec *ast.TypeSpec) ([]ast.Decl, error) {
+func typeSpecDoc(genDecl *ast.GenDecl, typeSpec *ast.TypeSpec) *ast.CommentGroup {
+	if typeSpec.Doc == nil && len(genDecl.Specs) == 1 {
+		return genDecl.Doc
+	}
+
+	return typeSpec.Doc
+}
+
+func (g *Generator) fromTypeSpec(typeSpec *ast.TypeSpec, doc *ast.CommentGroup) ([]ast.Decl, error) {
 	structType := typeutil.AsOrEmpty[*ast.StructType](typeSpec.Type)
 
 	if structType == nil {
 		return []ast.Decl{}, nil
 	}
 
-	return g.fromFieldList(typeSpec.Name.Name, structType.Fields)
+	directives, err := g.parseTypeDirectives(doc)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	g, err = g.forType(typeSpec.Name.Name, structType.Fields, directives)
+	if err != nil {
+		return nil, err
+	}
+
+	decls, err := g.fromFieldList(typeSpec.Name.Name, structType.Fields)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	_decls, err := g.structFuncDecls(typeSpec.Name.Name, structType.Fields, directives)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	decls = append(decls, _decls...)
+
+	g.renameLocals(decls)
+
+	return decls, nil
+}
+
+func (g *Generator) structFuncDecls(structName string, fieldList *ast.FieldList, directives typeDirectives) ([]ast.Decl, error) {
+	decls := declsOf(
+		g.applyDefaultsFuncDecl(structName, fieldList),
+	)
+
+	if directives.has("marshal", "json") {
+		decls = append(decls, g.jsonFuncDecls(structName, fieldList)...)
+	}
+
+	if directives.has("marshal", "text") {
+		_decls, err := g.textFuncDecls(structName, fieldList)
+		if err != nil {
+			return nil, err
+		}
+
+		decls = append(decls, _decls...)
+	}
+
+	if directives.has("marshal", "binary") {
+		_decls, err := g.binaryFuncDecls(structName, fieldList)
+		if err != nil {
+			return nil, err
+		}
+
+		decls = append(decls, _decls...)
+	}
+
+	if directives.enabled("equal") {
+		_decl, err := g.equalFuncDecl(structName, fieldList)
+		if err != nil {
+			return nil, err
+		}
+
+		decls = append(decls, _decl)
+	}
+
+	if directives.enabled("compare") {
+		_decls, err := g.compareFuncDecls(structName, fieldList, directives["compare"])
+		if err != nil {
+			return nil, err
+		}
+
+		decls = append(decls, _decls...)
+	}
+
+	if directives.enabled("clone") {
+		decls = append(decls, g.cloneFuncDecl(structName, fieldList))
+	}
+
+	if directives.enabled("interface") {
+		_decls, err := g.interfaceDecls(structName, fieldList)
+		if err != nil {
+			return nil, err
+		}
+
+		decls = append(decls, _decls...)
+	}
+
+	if directives.enabled("patch") {
+		_decls, err := g.patchDecls(structName, fieldList)
+		if err != nil {
+			return nil, err
+		}
+
+		decls = append(decls, _decls...)
+	}
+
+	if directives.enabled("fields") {
+		decls = append(decls, g.fieldsDecls(structName, fieldList)...)
+	}
+
+	if directives.enabled("diff") {
+		decl, err := g.diffFuncDecl(structName, fieldList)
+		if err != nil {
+			return nil, err
+		}
+
+		decls = append(decls, decl)
+	}
+
+	if directives.enabled("snapshot") {
+		_decls, err := g.snapshotDecls(structName, fieldList)
+		if err != nil {
+			return nil, err
+		}
+
+		decls = append(decls, _decls...)
+	}
+
+	for _, dto := range directives["dto"] {
+		_decls, err := g.dtoFuncDecls(structName, fieldList, dto)
+		if err != nil {
+			return nil, err
+		}
+
+		decls = append(decls, _decls...)
+	}
+
+	if directives.enabled("reset") {
+		decls = append(decls, g.resetAllFuncDecl(structName, fieldList))
+	}
+
+	if directives.enabled("log") {
+		decls = append(decls, g.logFuncDecls(structName, fieldList)...)
+	}
+
+	if directives.enabled("sql") {
+		_decls, err := g.sqlFuncDecls(structName, fieldList)
+		if err != nil {
+			return nil, err
+		}
+
+		decls = append(decls, _decls...)
+	}
+
+	return decls, nil
 }
 
 func (g *Generator) fromFieldList(structName string, fieldList *ast.FieldList) ([]ast.Decl, error) {
@@ -130,63 +339,97 @@ func (g *Generator) fromField(structName string, field *ast.Field) ([]ast.Decl,
 		return nil, errors.WithStack(err)
 	}
 
-	propertyTag := reflect.StructTag(tagValue).Get(g.config.TagName)
+	g.warnMultipleTags(structName, field)
+
+	propertyTag := g.propertyTagOf(field)
 	if propertyTag == "" || propertyTag == "-" {
 		return []ast.Decl{}, nil
 	}
 
+	err = g.checkNormalizeTag(reflect.StructTag(tagValue), field.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	err = g.checkDefaultTag(field)
+	if err != nil {
+		return nil, err
+	}
+
+	err = g.checkLazyDirective(field)
+	if err != nil {
+		return nil, err
+	}
+
 	directives := strings.Split(propertyTag, ",")
 
 	var decls []ast.Decl
 
 	for _, directive := range directives {
-		decl, err := g.processDirective(directive, structName, field)
+		_decls, err := g.processDirective(directive, structName, field)
 		if err != nil {
 			return nil, err
 		}
 
-		if decl != nil {
-			decls = append(decls, decl)
-		}
+		decls = append(decls, _decls...)
 	}
 
 	return decls, nil
 }
 
-func (g *Generator) processDirective(directive, structName string, field *ast.Field) (ast.Decl, error) {
+func (g *Generator) processDirective(directive, structName string, field *ast.Field) ([]ast.Decl, error) {
 	switch directive {
 	case "get":
-		return g.getterFuncDecl(structName, field), nil
+		return declsOf(g.getterFuncDecl("Get", structName, field)), nil
+
+	case "is", "toggle", "inc":
+		return g.convenienceFuncDecls(directive, structName, field)
 
 	case "set":
-		return g.setterFuncDecl("Set", structName, field), nil
+		return declsOf(g.setterFuncDecl("Set", structName, field)), nil
 
 	case "set=private":
-		return g.setterFuncDecl("set", structName, field), nil
+		return declsOf(g.setterFuncDecl("set", structName, field)), nil
 
-	default:
-		return nil, errors.Wrapf(errInvalidTagValue, "directive=%s", directive)
+	case "optional":
+		return g.optionalFuncDecls(structName, field)
+
+	case "reset":
+		return declsOf(g.resetFuncDecl(structName, field)), nil
+
+	case "nilsafe", "redact":
+		return []ast.Decl{}, nil
+	}
+
+	key, value, found := strings.Cut(directive, "=")
+	if found && optionDirectives[key] && token.IsIdentifier(value) {
+		return []ast.Decl{}, nil
+	}
+
+	return nil, errors.Wrapf(errInvalidTagValue, "directive=%s", directive)
+}
+
+func declsOf(decls ...ast.Decl) []ast.Decl {
+	var result []ast.Decl
+
+	for _, decl := range decls {
+		if decl != nil {
+			result = append(result, decl)
+		}
 	}
+
+	return result
 }
 
-func (g *Generator) getterFuncDecl(structName string, field *ast.Field) ast.Decl {
+func (g *Generator) getterFuncDecl(verb string, structName string, field *ast.Field) ast.Decl {
 	if len(field.Names) == 0 {
 		return nil
 	}
 
-	recv := astutil.NewFieldList(
-		[]*ast.Field{
-			astutil.NewField(
-				[]*ast.Ident{
-					astutil.NewIdent("t"),
-				},
-				astutil.NewStarExpr(astutil.NewIdent(structName)),
-			),
-		},
-	)
+	recv := g.buildGetterRecvFieldList(structName)
 
 	name := astutil.NewIdent(
-		"Get" + g.prepareFieldName(field.Names[0].Name),
+		verb + g.prepareFieldName(field.Names[0].Name),
 	)
 
 	funcType := astutil.NewFuncType(
@@ -199,17 +442,15 @@ func (g *Generator) getterFuncDecl(structName string, field *ast.Field) ast.Decl
 		),
 	)
 
-	body := astutil.NewBlockStmt(
-		[]ast.Stmt{
-			astutil.NewReturnStmt(
-				[]ast.Expr{
-					astutil.NewSelectorExpr(astutil.NewIdent("t"), astutil.NewIdent(field.Names[0].Name)),
-				},
-			),
-		},
-	)
+	body := g.buildGetterBody(field)
+
+	summary := fmt.Sprintf("returns the %s.", field.Names[0].Name)
+	if verb == "Is" {
+		summary = fmt.Sprintf("reports whether %s is true.", field.Names[0].Name)
+	}
 
 	return &ast.FuncDecl{
+		Doc:  accessorDoc(name.Name, summary, field),
 		Recv: recv,
 		Name: name,
 		Type: funcType,
@@ -217,6 +458,41 @@ func (g *Generator) getterFuncDecl(structName string, field *ast.Field) ast.Decl
 	}
 }
 
+func (g *Generator) buildGetterBody(field *ast.Field) *ast.BlockStmt {
+	var stmts []ast.Stmt
+
+	defaultExpr := g.defaultValueExprOf(field)
+
+	// false can not be told apart from an unset bool, so bool defaults are applied only by ApplyDefaults and Reset.
+	if isIdentType(field.Type, "bool") {
+		defaultExpr = nil
+	}
+
+	if !g.valueGetters && (g.config.NilSafe || g.hasDirective(field, "nilsafe")) {
+		stmts = append(stmts, g.buildNilReceiverStmt(field.Type, defaultExpr))
+	}
+
+	lazyInitStmt := g.buildLazyInitStmt(field)
+
+	switch {
+	case lazyInitStmt != nil:
+		stmts = append(stmts, lazyInitStmt)
+
+	case defaultExpr != nil:
+		stmts = append(stmts, g.buildDefaultReturnStmt(field, defaultExpr))
+	}
+
+	stmts = append(stmts,
+		astutil.NewReturnStmt(
+			[]ast.Expr{
+				astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name)),
+			},
+		),
+	)
+
+	return astutil.NewBlockStmt(stmts)
+}
+
 func (g *Generator) setterFuncDecl(verb string, structName string, field *ast.Field) ast.Decl {
 	if field.Tag == nil || len(field.Names) == 0 {
 		return nil
@@ -244,7 +520,7 @@ func (g *Generator) setterFuncNoValidationDecl(verb string, structName string, f
 		[]*ast.Field{
 			astutil.NewField(
 				[]*ast.Ident{
-					astutil.NewIdent("t"),
+					g.recvIdent(),
 				},
 				astutil.NewStarExpr(astutil.NewIdent(structName)),
 			),
@@ -257,28 +533,34 @@ func (g *Generator) setterFuncNoValidationDecl(verb string, structName string, f
 
 	funcType := g.buildSetterFuncType(field, false)
 
-	body := astutil.NewBlockStmt(
-		[]ast.Stmt{
-			astutil.NewAssignStmt(
-				[]ast.Expr{
-					astutil.NewSelectorExpr(astutil.NewIdent("t"), astutil.NewIdent(field.Names[0].Name)),
-				},
-				token.ASSIGN,
-				[]ast.Expr{
-					astutil.NewIdent("v"),
-				},
-			),
-		},
-	)
-
 	return &ast.FuncDecl{
+		Doc:  accessorDoc(name.Name, fmt.Sprintf("sets the %s.", field.Names[0].Name), field),
 		Recv: recv,
 		Name: name,
 		Type: funcType,
-		Body: body,
+		Body: g.buildNoValidationBody(field),
 	}
 }
 
+func (g *Generator) buildNoValidationBody(field *ast.Field) *ast.BlockStmt {
+	stmts := g.buildSetterBeforeStmts(field)
+
+	stmts = append(stmts,
+		astutil.NewAssignStmt(
+			[]ast.Expr{
+				astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name)),
+			},
+			token.ASSIGN,
+			[]ast.Expr{
+				astutil.NewIdent("v"),
+			},
+		),
+	)
+	stmts = append(stmts, g.buildSetterAfterStmts(field)...)
+
+	return astutil.NewBlockStmt(stmts)
+}
+
 func (g *Generator) setterFuncWithValidationDecl(
 	verb string, structName string, field *ast.Field, tag string,
 ) ast.Decl {
@@ -296,22 +578,36 @@ func (g *Generator) setterFuncWithValidationDecl(
 		return nil
 	}
 
+	name := astutil.NewIdent(
+		verb + g.prepareFieldName(field.Names[0].Name),
+	)
+
 	return &ast.FuncDecl{
+		Doc:  accessorDoc(name.Name, fmt.Sprintf("validates and sets the %s.", field.Names[0].Name), field),
 		Recv: g.buildRecvFieldList(structName),
-		Name: astutil.NewIdent(
-			verb + g.prepareFieldName(field.Names[0].Name),
-		),
+		Name: name,
 		Type: g.buildSetterFuncType(field, true),
 		Body: g.buildValidationBody(field, tag),
 	}
 }
 
+func (g *Generator) buildValidationCallExpr(field *ast.Field, tag string, valueExpr ast.Expr) ast.Expr {
+	return &ast.CallExpr{
+		Fun: astutil.NewIdent(g.config.ValidationFunc),
+		Args: []ast.Expr{
+			astutil.NewBasicLit(token.STRING, fmt.Sprintf("\"%s\"", field.Names[0].Name)),
+			valueExpr,
+			astutil.NewBasicLit(token.STRING, fmt.Sprintf("\"%s\"", tag)),
+		},
+	}
+}
+
 func (g *Generator) buildRecvFieldList(structName string) *ast.FieldList {
 	return astutil.NewFieldList(
 		[]*ast.Field{
 			astutil.NewField(
 				[]*ast.Ident{
-					astutil.NewIdent("t"),
+					g.recvIdent(),
 				},
 				astutil.NewStarExpr(astutil.NewIdent(structName)),
 			),
@@ -345,58 +641,56 @@ func (g *Generator) buildSetterFuncType(field *ast.Field, withError bool) *ast.F
 }
 
 func (g *Generator) buildValidationBody(field *ast.Field, tag string) *ast.BlockStmt {
-	callExpr := &ast.CallExpr{
-		Fun: astutil.NewIdent(g.config.ValidationFunc),
-		Args: []ast.Expr{
-			astutil.NewBasicLit(token.STRING, fmt.Sprintf("\"%s\"", field.Names[0].Name)),
-			astutil.NewIdent("v"),
-			astutil.NewBasicLit(token.STRING, fmt.Sprintf("\"%s\"", tag)),
-		},
-	}
+	callExpr := g.buildValidationCallExpr(field, tag, astutil.NewIdent("v"))
 
-	return astutil.NewBlockStmt(
-		[]ast.Stmt{
-			astutil.NewAssignStmt(
-				[]ast.Expr{
-					astutil.NewIdent("err"),
-				},
-				token.DEFINE,
-				[]ast.Expr{
-					callExpr,
-				},
-			),
-			&ast.IfStmt{
-				Cond: &ast.BinaryExpr{
-					Op: token.NEQ,
-					X:  astutil.NewIdent("err"),
-					Y:  astutil.NewIdent("nil"),
-				},
-				Body: astutil.NewBlockStmt(
-					[]ast.Stmt{
-						astutil.NewReturnStmt(
-							[]ast.Expr{
-								ast.NewIdent("err"),
-							},
-						),
-					},
-				),
+	stmts := g.buildSetterBeforeStmts(field)
+
+	stmts = append(stmts,
+		astutil.NewAssignStmt(
+			[]ast.Expr{
+				astutil.NewIdent("err"),
 			},
-			astutil.NewAssignStmt(
-				[]ast.Expr{
-					astutil.NewSelectorExpr(astutil.NewIdent("t"), astutil.NewIdent(field.Names[0].Name)),
-				},
-				token.ASSIGN,
-				[]ast.Expr{
-					astutil.NewIdent("v"),
-				},
-			),
-			astutil.NewReturnStmt(
-				[]ast.Expr{
-					astutil.NewIdent("nil"),
+			token.DEFINE,
+			[]ast.Expr{
+				callExpr,
+			},
+		),
+		&ast.IfStmt{
+			Cond: &ast.BinaryExpr{
+				Op: token.NEQ,
+				X:  astutil.NewIdent("err"),
+				Y:  astutil.NewIdent("nil"),
+			},
+			Body: astutil.NewBlockStmt(
+				[]ast.Stmt{
+					astutil.NewReturnStmt(
+						[]ast.Expr{
+							ast.NewIdent("err"),
+						},
+					),
 				},
 			),
 		},
+		astutil.NewAssignStmt(
+			[]ast.Expr{
+				astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name)),
+			},
+			token.ASSIGN,
+			[]ast.Expr{
+				astutil.NewIdent("v"),
+			},
+		),
 	)
+	stmts = append(stmts, g.buildSetterAfterStmts(field)...)
+	stmts = append(stmts,
+		astutil.NewReturnStmt(
+			[]ast.Expr{
+				astutil.NewIdent("nil"),
+			},
+		),
+	)
+
+	return astutil.NewBlockStmt(stmts)
 }
 
 var camelHeadPattern = regexp.MustCompile(`^[a-z]+`)
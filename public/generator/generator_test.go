@@ -65,6 +65,473 @@ func TestGenerator_Generate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:           "success: returns ast.Decl with setter hooks",
+			inputFileName:  "./testdata/setter_hook_input.go.txt",
+			outputFileName: "./testdata/setter_hook_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:        tagName,
+					Initialism:     []string{"api"},
+					ValidationFunc: "validateFieldValue",
+					ValidationTag:  "validate",
+				},
+			},
+		},
+		{
+			name:          "failure: returns error for invalid normalize",
+			inputFileName: "./testdata/invalid_normalize_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "invalid normalize value",
+		},
+		{
+			name:          "failure: returns error for normalize on non-string field",
+			inputFileName: "./testdata/invalid_normalize_type_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "invalid field type",
+		},
+		{
+			name:           "success: returns ast.Decl with nil-safe getter directive",
+			inputFileName:  "./testdata/nil_safe_input.go.txt",
+			outputFileName: "./testdata/nil_safe_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+		},
+		{
+			name:           "success: returns ast.Decl with nil-safe getter config",
+			inputFileName:  "./testdata/nil_safe_input.go.txt",
+			outputFileName: "./testdata/nil_safe_config_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+					NilSafe:    true,
+				},
+			},
+		},
+		{
+			name:           "success: returns ast.Decl with optional pointer helpers",
+			inputFileName:  "./testdata/optional_input.go.txt",
+			outputFileName: "./testdata/optional_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:        tagName,
+					Initialism:     []string{"api"},
+					ValidationFunc: "validateFieldValue",
+					ValidationTag:  "validate",
+				},
+			},
+		},
+		{
+			name:          "failure: returns error for optional non-pointer field",
+			inputFileName: "./testdata/invalid_optional_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "invalid field type",
+		},
+		{
+			name:           "success: returns ast.Decl with default values",
+			inputFileName:  "./testdata/default_input.go.txt",
+			outputFileName: "./testdata/default_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+		},
+		{
+			name:          "failure: returns error for invalid default",
+			inputFileName: "./testdata/invalid_default_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "invalid default value",
+		},
+		{
+			name:          "failure: returns error for NaN default",
+			inputFileName: "./testdata/invalid_default_float_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "invalid default value",
+		},
+		{
+			name:          "failure: returns error for default with lazy",
+			inputFileName: "./testdata/invalid_default_lazy_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "invalid tag value",
+		},
+		{
+			name:           "success: returns ast.Decl with lazy getters",
+			inputFileName:  "./testdata/lazy_input.go.txt",
+			outputFileName: "./testdata/lazy_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+		},
+		{
+			name:          "failure: returns error for lazy field without zero check",
+			inputFileName: "./testdata/invalid_lazy_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "invalid tag value",
+		},
+		{
+			name:           "success: returns ast.Decl with convenience methods",
+			inputFileName:  "./testdata/convenience_input.go.txt",
+			outputFileName: "./testdata/convenience_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:        tagName,
+					Initialism:     []string{"api"},
+					ValidationFunc: "validateFieldValue",
+					ValidationTag:  "validate",
+				},
+			},
+		},
+		{
+			name:          "failure: returns error for inc directive on string field",
+			inputFileName: "./testdata/invalid_convenience_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "invalid field type",
+		},
+		{
+			name:           "success: returns ast.Decl with JSON marshalers",
+			inputFileName:  "./testdata/marshal_json_input.go.txt",
+			outputFileName: "./testdata/marshal_json_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:        tagName,
+					Initialism:     []string{"api"},
+					ValidationFunc: "validateFieldValue",
+					ValidationTag:  "validate",
+				},
+			},
+		},
+		{
+			name:           "success: returns ast.Decl with text and binary marshalers",
+			inputFileName:  "./testdata/marshal_text_binary_input.go.txt",
+			outputFileName: "./testdata/marshal_text_binary_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:        tagName,
+					Initialism:     []string{"api"},
+					ValidationFunc: "validateFieldValue",
+					ValidationTag:  "validate",
+				},
+			},
+		},
+		{
+			name:          "failure: returns error for text marshaler with multiple fields",
+			inputFileName: "./testdata/invalid_marshal_text_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "marshal=text requires exactly one property field",
+		},
+		{
+			name:           "success: returns ast.Decl with sql helpers",
+			inputFileName:  "./testdata/sql_input.go.txt",
+			outputFileName: "./testdata/sql_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:        tagName,
+					Initialism:     []string{"api"},
+					ValidationFunc: "validateFieldValue",
+					ValidationTag:  "validate",
+				},
+			},
+		},
+		{
+			name:          "failure: returns error for sql without db tags",
+			inputFileName: "./testdata/invalid_sql_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "sql requires property fields with db tag",
+		},
+		{
+			name:           "success: returns ast.Decl with log value and redaction",
+			inputFileName:  "./testdata/log_input.go.txt",
+			outputFileName: "./testdata/log_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+		},
+		{
+			name:           "success: returns ast.Decl with equal and compare",
+			inputFileName:  "./testdata/equal_input.go.txt",
+			outputFileName: "./testdata/equal_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+		},
+		{
+			name:          "failure: returns error for unordered compare key",
+			inputFileName: "./testdata/invalid_compare_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "compare key must be ordered",
+		},
+		{
+			name:           "success: returns ast.Decl with clone",
+			inputFileName:  "./testdata/clone_input.go.txt",
+			outputFileName: "./testdata/clone_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+		},
+		{
+			name:           "success: returns ast.Decl with reader and writer interfaces",
+			inputFileName:  "./testdata/interface_input.go.txt",
+			outputFileName: "./testdata/interface_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:        tagName,
+					Initialism:     []string{"api"},
+					ValidationFunc: "validateFieldValue",
+					ValidationTag:  "validate",
+				},
+			},
+		},
+		{
+			name:           "success: returns ast.Decl with patch struct",
+			inputFileName:  "./testdata/patch_input.go.txt",
+			outputFileName: "./testdata/patch_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:        tagName,
+					Initialism:     []string{"api"},
+					ValidationFunc: "validateFieldValue",
+					ValidationTag:  "validate",
+				},
+			},
+		},
+		{
+			name:           "success: returns ast.Decl with field descriptors",
+			inputFileName:  "./testdata/fields_input.go.txt",
+			outputFileName: "./testdata/fields_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:        tagName,
+					Initialism:     []string{"api"},
+					ValidationFunc: "validateFieldValue",
+					ValidationTag:  "validate",
+				},
+			},
+		},
+		{
+			name:           "success: returns ast.Decl with diff",
+			inputFileName:  "./testdata/diff_input.go.txt",
+			outputFileName: "./testdata/diff_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+		},
+		{
+			name:           "success: returns ast.Decl with snapshot",
+			inputFileName:  "./testdata/snapshot_input.go.txt",
+			outputFileName: "./testdata/snapshot_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:        tagName,
+					Initialism:     []string{"api"},
+					ValidationFunc: "validateFieldValue",
+					ValidationTag:  "validate",
+				},
+			},
+		},
+		{
+			name:           "success: returns ast.Decl with dto conversion",
+			inputFileName:  "./testdata/dto_input.go.txt",
+			outputFileName: "./testdata/dto_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:        tagName,
+					Initialism:     []string{"id", "api"},
+					ValidationFunc: "validateFieldValue",
+					ValidationTag:  "validate",
+				},
+			},
+		},
+		{
+			name:          "failure: dto field not found",
+			inputFileName: "./testdata/invalid_dto_field_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"id", "api"},
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "dto field not found",
+		},
+		{
+			name:           "success: returns ast.Decl with reset",
+			inputFileName:  "./testdata/reset_input.go.txt",
+			outputFileName: "./testdata/reset_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+		},
+		{
+			name:          "failure: returns error for invalid type directive",
+			inputFileName: "./testdata/invalid_type_directive_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "invalid type directive",
+		},
+		{
+			name:           "success: returns ast.Decl with fallback tag names",
+			inputFileName:  "./testdata/fallback_tag_input.go.txt",
+			outputFileName: "./testdata/fallback_tag_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:          tagName,
+					FallbackTagNames: []string{"prop"},
+				},
+			},
+		},
+		{
+			name:           "success: returns ast.Decl with detected receiver names and value getters",
+			inputFileName:  "./testdata/receiver_input.go.txt",
+			outputFileName: "./testdata/receiver_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName: tagName,
+				},
+			},
+		},
+		{
+			name:           "success: returns ast.Decl with receiver name config",
+			inputFileName:  "./testdata/private_setter_input.go.txt",
+			outputFileName: "./testdata/receiver_config_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:    tagName,
+					Initialism: []string{"api"},
+					Receiver:   "ps",
+				},
+			},
+		},
+		{
+			name:          "failure: invalid receiver name config",
+			inputFileName: "./testdata/private_setter_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:  tagName,
+					Receiver: "json",
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "invalid receiver name",
+		},
+		{
+			name:          "failure: value getters with sync field",
+			inputFileName: "./testdata/invalid_value_getter_input.go.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName: tagName,
+				},
+			},
+			wantErr:        true,
+			wantErrMessage: "value receiver is not allowed",
+		},
+		{
+			name:           "success: returns ast.Decl with doc comments",
+			inputFileName:  "./testdata/doc_input.go.txt",
+			outputFileName: "./testdata/doc_output.txt",
+			fields: fields{
+				config: &GeneratorConfig{
+					TagName:        tagName,
+					ValidationFunc: "validateFieldValue",
+					ValidationTag:  "validate",
+				},
+			},
+		},
 		{
 			name:           "success: returns ast.Decl with private setter",
 			inputFileName:  "./testdata/private_setter_input.go.txt",
@@ -84,7 +551,7 @@ func TestGenerator_Generate(t *testing.T) {
 
 			fset := token.NewFileSet()
 
-			f, err := parser.ParseFile(token.NewFileSet(), tt.inputFileName, nil, parser.AllErrors)
+			f, err := parser.ParseFile(token.NewFileSet(), tt.inputFileName, nil, parser.AllErrors|parser.ParseComments)
 			if err != nil {
 				t.Errorf("fail to parser.ParseFile() tt.inputFileName=%v", tt.inputFileName)
 
@@ -100,24 +567,14 @@ func TestGenerator_Generate(t *testing.T) {
 
 			require.NoError(t, err)
 
-			_want := bytes.NewBuffer([]byte{})
-
-			{
-				f, err := parser.ParseFile(token.NewFileSet(), tt.outputFileName, nil, parser.AllErrors)
-				if err != nil {
-					t.Errorf("fail to parser.ParseFile() tt.outputFileName=%v", tt.outputFileName)
-
-					return
-				}
+			want, err := parser.ParseFile(token.NewFileSet(), tt.outputFileName, nil, parser.AllErrors|parser.ParseComments)
+			if err != nil {
+				t.Errorf("fail to parser.ParseFile() tt.outputFileName=%v", tt.outputFileName)
 
-				format.Node(_want, fset, f.Decls)
+				return
 			}
 
-			_got := bytes.NewBuffer([]byte{})
-
-			format.Node(_got, fset, got)
-
-			if !assert.Equal(t, _want.String(), _got.String()) {
+			if !assert.Equal(t, formatDecls(t, want.Decls), formatDecls(t, got)) {
 				return
 			}
 		})
@@ -266,7 +723,7 @@ func TestFromTypeSpec(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			decls, err := generator.fromTypeSpec(tt.typeSpec)
+			decls, err := generator.fromTypeSpec(tt.typeSpec, nil)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -410,7 +867,7 @@ func TestGetterFuncDecl(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			decl := generator.getterFuncDecl(tt.structName, tt.field)
+			decl := generator.getterFuncDecl("Get", tt.structName, tt.field)
 
 			if tt.wantNil {
 				assert.Nil(t, decl)
@@ -751,6 +1208,7 @@ func TestGenerator_processDirective(t *testing.T) {
 		name      string
 		directive string
 		wantErr   bool
+		wantEmpty bool
 	}{
 		{
 			name:      "success: get directive",
@@ -762,30 +1220,67 @@ func TestGenerator_processDirective(t *testing.T) {
 			directive: "set",
 			wantErr:   false,
 		},
+		{
+			name:      "success: reset directive",
+			directive: "reset",
+			wantErr:   false,
+		},
 		{
 			name:      "success: set=private directive",
 			directive: "set=private",
 			wantErr:   false,
 		},
+		{
+			name:      "success: before option directive",
+			directive: "before=normalize",
+			wantErr:   false,
+			wantEmpty: true,
+		},
+		{
+			name:      "success: after option directive",
+			directive: "after=changed",
+			wantErr:   false,
+			wantEmpty: true,
+		},
+		{
+			name:      "success: nilsafe option directive",
+			directive: "nilsafe",
+			wantErr:   false,
+			wantEmpty: true,
+		},
+		{
+			name:      "success: redact option directive",
+			directive: "redact",
+			wantErr:   false,
+			wantEmpty: true,
+		},
 		{
 			name:      "failure: invalid directive",
 			directive: "invalid",
 			wantErr:   true,
 		},
+		{
+			name:      "failure: option directive without identifier",
+			directive: "before=",
+			wantErr:   true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			decl, err := generator.processDirective(tt.directive, "TestStruct", field)
+			decls, err := generator.processDirective(tt.directive, "TestStruct", field)
 
 			if tt.wantErr {
 				assert.Error(t, err)
-				assert.Nil(t, decl)
+				assert.Nil(t, decls)
+			} else if tt.wantEmpty {
+				assert.NoError(t, err)
+				assert.Empty(t, decls)
 			} else {
 				assert.NoError(t, err)
-				assert.NotNil(t, decl)
+				assert.NotEmpty(t, decls)
 			}
 		})
 	}
@@ -934,3 +1429,28 @@ func TestBuildValidationBody(t *testing.T) {
 		})
 	}
 }
+
+// formatDecls formats declarations together with the doc comments of functions,
+// which go/format can not place correctly for generated nodes without positions.
+func formatDecls(t *testing.T, decls []ast.Decl) string {
+	t.Helper()
+
+	buffer := bytes.NewBuffer([]byte{})
+
+	for _, decl := range decls {
+		if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Doc != nil {
+			for _, comment := range funcDecl.Doc.List {
+				buffer.WriteString(comment.Text + "\n")
+			}
+
+			undocumented := *funcDecl
+			undocumented.Doc = nil
+			decl = &undocumented
+		}
+
+		require.NoError(t, format.Node(buffer, token.NewFileSet(), decl))
+		buffer.WriteString("\n")
+	}
+
+	return buffer.String()
+}
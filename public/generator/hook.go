@@ -0,0 +1,148 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"reflect"
+	"strings"
+
+	"github.com/hidori/go-astutil"
+	"github.com/pkg/errors"
+)
+
+const normalizeTagName = "normalize"
+
+var normalizeFuncs = map[string]string{
+	"trim":  "TrimSpace",
+	"lower": "ToLower",
+	"upper": "ToUpper",
+}
+
+var errInvalidNormalizeValue = errors.New("invalid normalize value")
+
+func (g *Generator) checkNormalizeTag(tag reflect.StructTag, fieldType ast.Expr) error {
+	normalizeTag := tag.Get(normalizeTagName)
+	if normalizeTag == "" {
+		return nil
+	}
+
+	if !isIdentType(fieldType, "string") && !isStringPointerType(fieldType) {
+		return errors.Wrapf(errInvalidFieldType, "normalize=%s", normalizeTag)
+	}
+
+	for _, name := range strings.Split(normalizeTag, ",") {
+		if _, ok := normalizeFuncs[name]; !ok {
+			return errors.Wrapf(errInvalidNormalizeValue, "normalize=%s", name)
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) buildSetterBeforeStmts(field *ast.Field) []ast.Stmt {
+	return g.buildBeforeStmts(field, "v")
+}
+
+// buildBeforeStmts normalizes the value of the named variable and passes it to the before hook.
+func (g *Generator) buildBeforeStmts(field *ast.Field, name string) []ast.Stmt {
+	var stmts []ast.Stmt
+
+	normalizeTag := structTag(field).Get(normalizeTagName)
+	if normalizeTag != "" {
+		if isStringPointerType(field.Type) {
+			stmts = append(stmts, g.buildNormalizePointerStmt(name, strings.Split(normalizeTag, ",")))
+		} else {
+			for _, normalizeName := range strings.Split(normalizeTag, ",") {
+				funcName, ok := normalizeFuncs[normalizeName]
+				if !ok {
+					continue
+				}
+
+				stmts = append(stmts, g.buildAssignCallStmt(name,
+					astutil.NewSelectorExpr(astutil.NewIdent("strings"), astutil.NewIdent(funcName)),
+				))
+			}
+		}
+	}
+
+	before, ok := g.directiveValue(field, "before")
+	if ok {
+		stmts = append(stmts, g.buildAssignCallStmt(name,
+			astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(before)),
+		))
+	}
+
+	return stmts
+}
+
+func (g *Generator) buildSetterAfterStmts(field *ast.Field) []ast.Stmt {
+	after, ok := g.directiveValue(field, "after")
+	if !ok {
+		return nil
+	}
+
+	return []ast.Stmt{
+		&ast.ExprStmt{
+			X: &ast.CallExpr{
+				Fun: astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(after)),
+			},
+		},
+	}
+}
+
+func (g *Generator) buildAssignCallStmt(name string, fun ast.Expr) ast.Stmt {
+	return astutil.NewAssignStmt(
+		[]ast.Expr{
+			astutil.NewIdent(name),
+		},
+		token.ASSIGN,
+		[]ast.Expr{
+			&ast.CallExpr{
+				Fun: fun,
+				Args: []ast.Expr{
+					astutil.NewIdent(name),
+				},
+			},
+		},
+	)
+}
+
+// buildNormalizePointerStmt normalizes the value pointed by the named variable into a new variable,
+// so that the string of the caller is not modified.
+func (g *Generator) buildNormalizePointerStmt(name string, normalizeNames []string) ast.Stmt {
+	expr := ast.Expr(&ast.StarExpr{X: astutil.NewIdent(name)})
+
+	for _, normalizeName := range normalizeNames {
+		funcName, ok := normalizeFuncs[normalizeName]
+		if !ok {
+			continue
+		}
+
+		expr = &ast.CallExpr{
+			Fun:  astutil.NewSelectorExpr(astutil.NewIdent("strings"), astutil.NewIdent(funcName)),
+			Args: []ast.Expr{expr},
+		}
+	}
+
+	return &ast.IfStmt{
+		Cond: &ast.BinaryExpr{
+			Op: token.NEQ,
+			X:  astutil.NewIdent(name),
+			Y:  astutil.NewIdent("nil"),
+		},
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				astutil.NewAssignStmt(
+					[]ast.Expr{astutil.NewIdent("normalized")},
+					token.DEFINE,
+					[]ast.Expr{expr},
+				),
+				astutil.NewAssignStmt(
+					[]ast.Expr{astutil.NewIdent(name)},
+					token.ASSIGN,
+					[]ast.Expr{&ast.UnaryExpr{Op: token.AND, X: astutil.NewIdent("normalized")}},
+				),
+			},
+		),
+	}
+}
@@ -0,0 +1,134 @@
+package generator
+
+import (
+	"go/ast"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckNormalizeTag(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name      string
+		tag       string
+		fieldType ast.Expr
+		wantErr   error
+	}{
+		{
+			name:      "success: no normalize tag",
+			tag:       `property:"set"`,
+			fieldType: &ast.Ident{Name: "int"},
+		},
+		{
+			name:      "success: known normalize values",
+			tag:       `property:"set" normalize:"trim,lower,upper"`,
+			fieldType: &ast.Ident{Name: "string"},
+		},
+		{
+			name:      "success: string pointer",
+			tag:       `property:"set" normalize:"trim"`,
+			fieldType: &ast.StarExpr{X: &ast.Ident{Name: "string"}},
+		},
+		{
+			name:      "failure: unknown normalize value",
+			tag:       `property:"set" normalize:"trim,title"`,
+			fieldType: &ast.Ident{Name: "string"},
+			wantErr:   errInvalidNormalizeValue,
+		},
+		{
+			name:      "failure: non-string field",
+			tag:       `property:"set" normalize:"trim"`,
+			fieldType: &ast.Ident{Name: "int"},
+			wantErr:   errInvalidFieldType,
+		},
+		{
+			name:      "failure: string slice field",
+			tag:       `property:"set" normalize:"lower"`,
+			fieldType: &ast.ArrayType{Elt: &ast.Ident{Name: "string"}},
+			wantErr:   errInvalidFieldType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := generator.checkNormalizeTag(reflect.StructTag(tt.tag), tt.fieldType)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBuildSetterHookStmts(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name       string
+		tag        string
+		fieldType  ast.Expr
+		wantBefore int
+		wantAfter  int
+	}{
+		{
+			name:       "success: no hooks",
+			tag:        "`property:\"set\"`",
+			wantBefore: 0,
+			wantAfter:  0,
+		},
+		{
+			name:       "success: normalize and before hook",
+			tag:        "`property:\"set,before=norm\" normalize:\"trim,lower\"`",
+			wantBefore: 3,
+			wantAfter:  0,
+		},
+		{
+			name:       "success: after hook",
+			tag:        "`property:\"set,after=changed\"`",
+			wantBefore: 0,
+			wantAfter:  1,
+		},
+		{
+			name:       "success: normalize string pointer",
+			tag:        "`property:\"set\" normalize:\"trim,lower\"`",
+			fieldType:  &ast.StarExpr{X: &ast.Ident{Name: "string"}},
+			wantBefore: 1,
+			wantAfter:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fieldType := tt.fieldType
+			if fieldType == nil {
+				fieldType = &ast.Ident{Name: "string"}
+			}
+
+			field := &ast.Field{
+				Names: []*ast.Ident{{Name: "value"}},
+				Type:  fieldType,
+				Tag:   &ast.BasicLit{Value: tt.tag},
+			}
+
+			assert.Len(t, generator.buildSetterBeforeStmts(field), tt.wantBefore)
+			assert.Len(t, generator.buildSetterAfterStmts(field), tt.wantAfter)
+		})
+	}
+}
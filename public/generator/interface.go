@@ -0,0 +1,98 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/hidori/go-astutil"
+	"github.com/hidori/go-typeutil"
+	"github.com/pkg/errors"
+)
+
+func (g *Generator) interfaceDecls(structName string, fieldList *ast.FieldList) ([]ast.Decl, error) {
+	readerMethods, writerMethods := g.interfaceMethods(structName, fieldList)
+
+	if len(readerMethods) < 1 && len(writerMethods) < 1 {
+		return nil, errors.Wrapf(errInvalidTypeDirective, "interface requires exported getters or setters: type=%s", structName)
+	}
+
+	var decls []ast.Decl
+
+	if len(readerMethods) > 0 {
+		decls = append(decls, g.buildInterfaceDecls(structName, structName+"Reader", readerMethods)...)
+	}
+
+	if len(writerMethods) > 0 {
+		decls = append(decls, g.buildInterfaceDecls(structName, structName+"Writer", writerMethods)...)
+	}
+
+	return decls, nil
+}
+
+func (g *Generator) interfaceMethods(structName string, fieldList *ast.FieldList) ([]*ast.Field, []*ast.Field) {
+	var readerMethods, writerMethods []*ast.Field
+
+	for _, field := range g.propertyFields(fieldList, nil) {
+		if g.hasDirective(field, "get") {
+			readerMethods = append(readerMethods, g.interfaceMethodOf(g.getterFuncDecl("Get", structName, field)))
+		}
+
+		if g.hasDirective(field, "is") {
+			readerMethods = append(readerMethods, g.interfaceMethodOf(g.getterFuncDecl("Is", structName, field)))
+		}
+
+		if g.hasDirective(field, "set") {
+			writerMethods = append(writerMethods, g.interfaceMethodOf(g.setterFuncDecl("Set", structName, field)))
+		}
+	}
+
+	return readerMethods, writerMethods
+}
+
+func (g *Generator) interfaceMethodOf(decl ast.Decl) *ast.Field {
+	funcDecl := typeutil.AsOrEmpty[*ast.FuncDecl](decl)
+
+	return astutil.NewField(
+		[]*ast.Ident{
+			astutil.NewIdent(funcDecl.Name.Name),
+		},
+		funcDecl.Type,
+	)
+}
+
+func (g *Generator) buildInterfaceDecls(structName string, interfaceName string, methods []*ast.Field) []ast.Decl {
+	return []ast.Decl{
+		&ast.GenDecl{
+			Tok: token.TYPE,
+			Specs: []ast.Spec{
+				&ast.TypeSpec{
+					Name: astutil.NewIdent(interfaceName),
+					Type: &ast.InterfaceType{
+						Methods: astutil.NewFieldList(methods),
+					},
+				},
+			},
+		},
+		g.buildAssertionDecl(interfaceName, structName),
+	}
+}
+
+func (g *Generator) buildAssertionDecl(interfaceName string, typeName string) ast.Decl {
+	return &ast.GenDecl{
+		Tok: token.VAR,
+		Specs: []ast.Spec{
+			&ast.ValueSpec{
+				Names: []*ast.Ident{
+					astutil.NewIdent("_"),
+				},
+				Type: astutil.NewIdent(interfaceName),
+				Values: []ast.Expr{
+					&ast.CallExpr{
+						Fun:  &ast.ParenExpr{X: astutil.NewStarExpr(astutil.NewIdent(typeName))},
+						Args: []ast.Expr{astutil.NewIdent("nil")},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterfaceDecls(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name      string
+		tags      []string
+		wantNames []string
+		wantErr   bool
+	}{
+		{
+			name:      "success: reader and writer",
+			tags:      []string{"`property:\"get,set\"`", "`property:\"is\"`"},
+			wantNames: []string{"TestStructReader", "TestStructWriter"},
+		},
+		{
+			name:      "success: reader only",
+			tags:      []string{"`property:\"get,set=private\"`"},
+			wantNames: []string{"TestStructReader"},
+		},
+		{
+			name:      "success: writer only",
+			tags:      []string{"`property:\"set\"`"},
+			wantNames: []string{"TestStructWriter"},
+		},
+		{
+			name:    "failure: no exported getters or setters",
+			tags:    []string{"`property:\"set=private\"`"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fieldList := &ast.FieldList{}
+
+			for _, tag := range tt.tags {
+				fieldList.List = append(fieldList.List, &ast.Field{
+					Names: []*ast.Ident{{Name: "value"}},
+					Type:  &ast.Ident{Name: "string"},
+					Tag:   &ast.BasicLit{Value: tag},
+				})
+			}
+
+			decls, err := generator.interfaceDecls("TestStruct", fieldList)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errInvalidTypeDirective)
+				return
+			}
+
+			require.NoError(t, err)
+
+			var names []string
+
+			for _, decl := range decls {
+				genDecl := decl.(*ast.GenDecl)
+				if typeSpec, ok := genDecl.Specs[0].(*ast.TypeSpec); ok {
+					names = append(names, typeSpec.Name.Name)
+				}
+			}
+
+			assert.Equal(t, tt.wantNames, names)
+			assert.Len(t, decls, len(tt.wantNames)*2)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/hidori/go-astutil"
+	"github.com/pkg/errors"
+)
+
+func (g *Generator) checkLazyDirective(field *ast.Field) error {
+	_, ok := g.directiveValue(field, "lazy")
+	if !ok || len(field.Names) == 0 {
+		return nil
+	}
+
+	_, ok = g.directiveValue(field, "once")
+	if ok {
+		return nil
+	}
+
+	if g.buildZeroCheckExpr(astutil.NewIdent(field.Names[0].Name), field.Type) == nil {
+		return errors.Wrapf(errInvalidTagValue, "lazy field requires once=<field>: field=%s", field.Names[0].Name)
+	}
+
+	return nil
+}
+
+func (g *Generator) buildLazyInitStmt(field *ast.Field) ast.Stmt {
+	lazy, ok := g.directiveValue(field, "lazy")
+	if !ok {
+		return nil
+	}
+
+	selectorExpr := astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name))
+
+	assignStmt := astutil.NewAssignStmt(
+		[]ast.Expr{
+			selectorExpr,
+		},
+		token.ASSIGN,
+		[]ast.Expr{
+			&ast.CallExpr{
+				Fun: astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(lazy)),
+			},
+		},
+	)
+
+	once, ok := g.directiveValue(field, "once")
+	if ok {
+		return &ast.ExprStmt{
+			X: &ast.CallExpr{
+				Fun: astutil.NewSelectorExpr(
+					astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(once)),
+					astutil.NewIdent("Do"),
+				),
+				Args: []ast.Expr{
+					&ast.FuncLit{
+						Type: astutil.NewFuncType(nil, nil, nil),
+						Body: astutil.NewBlockStmt(
+							[]ast.Stmt{
+								assignStmt,
+							},
+						),
+					},
+				},
+			},
+		}
+	}
+
+	return &ast.IfStmt{
+		Cond: g.buildZeroCheckExpr(selectorExpr, field.Type),
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				assignStmt,
+			},
+		),
+	}
+}
@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildLazyInitStmt(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name     string
+		field    *ast.Field
+		wantStmt ast.Stmt
+		wantErr  bool
+	}{
+		{
+			name: "success: no lazy directive returns nil",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "index"}},
+				Type:  &ast.MapType{Key: &ast.Ident{Name: "string"}, Value: &ast.Ident{Name: "int"}},
+				Tag:   &ast.BasicLit{Value: "`property:\"get\"`"},
+			},
+			wantStmt: nil,
+		},
+		{
+			name: "success: lazy directive returns if statement",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "index"}},
+				Type:  &ast.MapType{Key: &ast.Ident{Name: "string"}, Value: &ast.Ident{Name: "int"}},
+				Tag:   &ast.BasicLit{Value: "`property:\"get,lazy=buildIndex\"`"},
+			},
+			wantStmt: &ast.IfStmt{},
+		},
+		{
+			name: "success: lazy directive with once returns expression statement",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "other"}},
+				Type:  &ast.Ident{Name: "OtherStruct"},
+				Tag:   &ast.BasicLit{Value: "`property:\"get,lazy=buildOther,once=otherOnce\"`"},
+			},
+			wantStmt: &ast.ExprStmt{},
+		},
+		{
+			name: "failure: lazy directive without zero check",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "other"}},
+				Type:  &ast.Ident{Name: "OtherStruct"},
+				Tag:   &ast.BasicLit{Value: "`property:\"get,lazy=buildOther\"`"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := generator.checkLazyDirective(tt.field)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errInvalidTagValue)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			stmt := generator.buildLazyInitStmt(tt.field)
+
+			if tt.wantStmt == nil {
+				assert.Nil(t, stmt)
+			} else {
+				assert.IsType(t, tt.wantStmt, stmt)
+			}
+		})
+	}
+}
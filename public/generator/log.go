@@ -0,0 +1,127 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/hidori/go-astutil"
+)
+
+const redactedValue = "***"
+
+func (g *Generator) logFuncDecls(structName string, fieldList *ast.FieldList) []ast.Decl {
+	fields := g.propertyFields(fieldList, g.isGetterVisible)
+
+	return []ast.Decl{
+		g.logValueFuncDecl(structName, fields),
+		g.stringFuncDecl(structName, fields),
+	}
+}
+
+func (g *Generator) isGetterVisible(field *ast.Field) bool {
+	return g.hasDirective(field, "get") || g.hasDirective(field, "is")
+}
+
+func (g *Generator) logValueFuncDecl(structName string, fields []*ast.Field) ast.Decl {
+	var attrs []ast.Expr
+
+	for _, field := range fields {
+		name := field.Names[0].Name
+
+		if g.hasDirective(field, "redact") {
+			attrs = append(attrs, &ast.CallExpr{
+				Fun: astutil.NewSelectorExpr(astutil.NewIdent("slog"), astutil.NewIdent("String")),
+				Args: []ast.Expr{
+					astutil.NewBasicLit(token.STRING, strconv.Quote(name)),
+					astutil.NewBasicLit(token.STRING, strconv.Quote(redactedValue)),
+				},
+			})
+
+			continue
+		}
+
+		attrs = append(attrs, &ast.CallExpr{
+			Fun: astutil.NewSelectorExpr(astutil.NewIdent("slog"), astutil.NewIdent("Any")),
+			Args: []ast.Expr{
+				astutil.NewBasicLit(token.STRING, strconv.Quote(name)),
+				astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(name)),
+			},
+		})
+	}
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("LogValue"),
+		Type: astutil.NewFuncType(
+			nil,
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(nil, astutil.NewSelectorExpr(astutil.NewIdent("slog"), astutil.NewIdent("Value"))),
+				},
+			),
+		),
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				astutil.NewReturnStmt(
+					[]ast.Expr{
+						&ast.CallExpr{
+							Fun:  astutil.NewSelectorExpr(astutil.NewIdent("slog"), astutil.NewIdent("GroupValue")),
+							Args: attrs,
+						},
+					},
+				),
+			},
+		),
+	}
+}
+
+func (g *Generator) stringFuncDecl(structName string, fields []*ast.Field) ast.Decl {
+	var verbs []string
+
+	var args []ast.Expr
+
+	for _, field := range fields {
+		name := field.Names[0].Name
+
+		if g.hasDirective(field, "redact") {
+			verbs = append(verbs, name+":"+redactedValue)
+
+			continue
+		}
+
+		verbs = append(verbs, name+":%v")
+		args = append(args, astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(name)))
+	}
+
+	format := fmt.Sprintf("%s{%s}", structName, strings.Join(verbs, " "))
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("String"),
+		Type: astutil.NewFuncType(
+			nil,
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(nil, astutil.NewIdent("string")),
+				},
+			),
+		),
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				astutil.NewReturnStmt(
+					[]ast.Expr{
+						&ast.CallExpr{
+							Fun:  astutil.NewSelectorExpr(astutil.NewIdent("fmt"), astutil.NewIdent("Sprintf")),
+							Args: append([]ast.Expr{astutil.NewBasicLit(token.STRING, strconv.Quote(format))}, args...),
+						},
+					},
+				),
+			},
+		),
+	}
+}
@@ -0,0 +1,101 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsGetterVisible(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name string
+		tag  string
+		want bool
+	}{
+		{
+			name: "success: getter",
+			tag:  "`property:\"get,redact\"`",
+			want: true,
+		},
+		{
+			name: "success: is",
+			tag:  "`property:\"is\"`",
+			want: true,
+		},
+		{
+			name: "success: setter only",
+			tag:  "`property:\"set=private,redact\"`",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			field := &ast.Field{
+				Names: []*ast.Ident{{Name: "value"}},
+				Type:  &ast.Ident{Name: "string"},
+				Tag:   &ast.BasicLit{Value: tt.tag},
+			}
+
+			assert.Equal(t, tt.want, generator.isGetterVisible(field))
+		})
+	}
+}
+
+func TestStringFuncDecl(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name       string
+		fields     []*ast.Field
+		wantFormat string
+		wantArgs   int
+	}{
+		{
+			name:       "success: no fields",
+			wantFormat: "\"TestStruct{}\"",
+		},
+		{
+			name: "success: redacted field is not passed as argument",
+			fields: []*ast.Field{
+				{
+					Names: []*ast.Ident{{Name: "name"}},
+					Type:  &ast.Ident{Name: "string"},
+					Tag:   &ast.BasicLit{Value: "`property:\"get\"`"},
+				},
+				{
+					Names: []*ast.Ident{{Name: "token"}},
+					Type:  &ast.Ident{Name: "string"},
+					Tag:   &ast.BasicLit{Value: "`property:\"get,redact\"`"},
+				},
+			},
+			wantFormat: "\"TestStruct{name:%v token:***}\"",
+			wantArgs:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			funcDecl := generator.stringFuncDecl("TestStruct", tt.fields).(*ast.FuncDecl)
+			callExpr := funcDecl.Body.List[0].(*ast.ReturnStmt).Results[0].(*ast.CallExpr)
+
+			assert.Equal(t, tt.wantFormat, callExpr.Args[0].(*ast.BasicLit).Value)
+			assert.Len(t, callExpr.Args, tt.wantArgs+1)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/hidori/go-astutil"
+)
+
+const jsonTagName = "json"
+
+func (g *Generator) jsonFuncDecls(structName string, fieldList *ast.FieldList) []ast.Decl {
+	fields := g.propertyFields(fieldList, func(field *ast.Field) bool {
+		return structTag(field).Get(jsonTagName) != "-"
+	})
+
+	return []ast.Decl{
+		g.marshalJSONFuncDecl(structName, fields),
+		g.unmarshalJSONFuncDecl(structName, fields),
+	}
+}
+
+func (g *Generator) marshalJSONFuncDecl(structName string, fields []*ast.Field) ast.Decl {
+	stmts := []ast.Stmt{
+		g.buildCodecVarStmt(g.buildCodecStructType(fields, g.jsonFieldTag)),
+	}
+
+	stmts = append(stmts, g.buildStoreStmts(fields, astutil.NewIdent("v"))...)
+	stmts = append(stmts, astutil.NewReturnStmt(
+		[]ast.Expr{
+			&ast.CallExpr{
+				Fun:  astutil.NewSelectorExpr(astutil.NewIdent("json"), astutil.NewIdent("Marshal")),
+				Args: []ast.Expr{astutil.NewIdent("v")},
+			},
+		},
+	))
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("MarshalJSON"),
+		Type: g.buildMarshalFuncType(),
+		Body: astutil.NewBlockStmt(stmts),
+	}
+}
+
+func (g *Generator) unmarshalJSONFuncDecl(structName string, fields []*ast.Field) ast.Decl {
+	stmts := []ast.Stmt{
+		g.buildCodecVarStmt(g.buildCodecStructType(fields, g.jsonFieldTag)),
+	}
+
+	// Copies of the current values are stored first, so that fields missing from data keep their values,
+	// and json.Unmarshal does not write into the slices, maps and pointees of the struct.
+	for _, field := range fields {
+		stmts = append(stmts, g.buildCloneStmt(
+			astutil.NewSelectorExpr(astutil.NewIdent("v"), astutil.NewIdent(g.prepareFieldName(field.Names[0].Name))),
+			astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name)),
+			field.Type,
+			1,
+		))
+	}
+
+	stmts = append(stmts, g.buildReturnIfErrStmt(&ast.CallExpr{
+		Fun: astutil.NewSelectorExpr(astutil.NewIdent("json"), astutil.NewIdent("Unmarshal")),
+		Args: []ast.Expr{
+			astutil.NewIdent("data"),
+			&ast.UnaryExpr{Op: token.AND, X: astutil.NewIdent("v")},
+		},
+	}))
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("UnmarshalJSON"),
+		Type: g.buildUnmarshalFuncType(),
+		Body: astutil.NewBlockStmt(append(stmts, g.buildRestoreStmts(fields, astutil.NewIdent("v"))...)),
+	}
+}
+
+func (g *Generator) buildMarshalFuncType() *ast.FuncType {
+	return astutil.NewFuncType(
+		nil,
+		nil,
+		astutil.NewFieldList(
+			[]*ast.Field{
+				astutil.NewField(nil, &ast.ArrayType{Elt: astutil.NewIdent("byte")}),
+				astutil.NewField(nil, astutil.NewIdent("error")),
+			},
+		),
+	)
+}
+
+func (g *Generator) buildUnmarshalFuncType() *ast.FuncType {
+	return astutil.NewFuncType(
+		nil,
+		astutil.NewFieldList(
+			[]*ast.Field{
+				astutil.NewField(
+					[]*ast.Ident{
+						astutil.NewIdent("data"),
+					},
+					&ast.ArrayType{Elt: astutil.NewIdent("byte")},
+				),
+			},
+		),
+		astutil.NewFieldList(
+			[]*ast.Field{
+				astutil.NewField(nil, astutil.NewIdent("error")),
+			},
+		),
+	)
+}
+
+func (g *Generator) jsonFieldTag(field *ast.Field) string {
+	name, options, found := strings.Cut(structTag(field).Get(jsonTagName), ",")
+	if name == "" {
+		name = field.Names[0].Name
+	}
+
+	if found {
+		name += "," + options
+	}
+
+	return fmt.Sprintf("`%s:%q`", jsonTagName, name)
+}
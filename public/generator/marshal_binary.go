@@ -0,0 +1,89 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/hidori/go-astutil"
+	"github.com/pkg/errors"
+)
+
+func (g *Generator) binaryFuncDecls(structName string, fieldList *ast.FieldList) ([]ast.Decl, error) {
+	fields := g.propertyFields(fieldList, nil)
+	if len(fields) < 1 {
+		return nil, errors.Wrapf(errInvalidTypeDirective, "marshal=binary requires property fields: type=%s", structName)
+	}
+
+	return []ast.Decl{
+		g.marshalBinaryFuncDecl(structName, fields),
+		g.unmarshalBinaryFuncDecl(structName, fields),
+	}, nil
+}
+
+func (g *Generator) marshalBinaryFuncDecl(structName string, fields []*ast.Field) ast.Decl {
+	stmts := []ast.Stmt{
+		g.buildCodecVarStmt(g.buildCodecStructType(fields, nil)),
+	}
+
+	stmts = append(stmts, g.buildStoreStmts(fields, astutil.NewIdent("v"))...)
+	stmts = append(stmts,
+		g.buildVarStmt("buf", astutil.NewSelectorExpr(astutil.NewIdent("bytes"), astutil.NewIdent("Buffer"))),
+		g.buildIfErrStmt(
+			[]ast.Expr{astutil.NewIdent("err")},
+			&ast.CallExpr{
+				Fun: astutil.NewSelectorExpr(
+					&ast.CallExpr{
+						Fun:  astutil.NewSelectorExpr(astutil.NewIdent("gob"), astutil.NewIdent("NewEncoder")),
+						Args: []ast.Expr{&ast.UnaryExpr{Op: token.AND, X: astutil.NewIdent("buf")}},
+					},
+					astutil.NewIdent("Encode"),
+				),
+				Args: []ast.Expr{astutil.NewIdent("v")},
+			},
+			[]ast.Expr{astutil.NewIdent("nil"), astutil.NewIdent("err")},
+		),
+		astutil.NewReturnStmt(
+			[]ast.Expr{
+				&ast.CallExpr{
+					Fun: astutil.NewSelectorExpr(astutil.NewIdent("buf"), astutil.NewIdent("Bytes")),
+				},
+				astutil.NewIdent("nil"),
+			},
+		),
+	)
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("MarshalBinary"),
+		Type: g.buildMarshalFuncType(),
+		Body: astutil.NewBlockStmt(stmts),
+	}
+}
+
+func (g *Generator) unmarshalBinaryFuncDecl(structName string, fields []*ast.Field) ast.Decl {
+	stmts := []ast.Stmt{
+		g.buildCodecVarStmt(g.buildCodecStructType(fields, nil)),
+		g.buildReturnIfErrStmt(&ast.CallExpr{
+			Fun: astutil.NewSelectorExpr(
+				&ast.CallExpr{
+					Fun: astutil.NewSelectorExpr(astutil.NewIdent("gob"), astutil.NewIdent("NewDecoder")),
+					Args: []ast.Expr{
+						&ast.CallExpr{
+							Fun:  astutil.NewSelectorExpr(astutil.NewIdent("bytes"), astutil.NewIdent("NewReader")),
+							Args: []ast.Expr{astutil.NewIdent("data")},
+						},
+					},
+				},
+				astutil.NewIdent("Decode"),
+			),
+			Args: []ast.Expr{&ast.UnaryExpr{Op: token.AND, X: astutil.NewIdent("v")}},
+		}),
+	}
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("UnmarshalBinary"),
+		Type: g.buildUnmarshalFuncType(),
+		Body: astutil.NewBlockStmt(append(stmts, g.buildRestoreStmts(fields, astutil.NewIdent("v"))...)),
+	}
+}
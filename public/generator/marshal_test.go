@@ -0,0 +1,55 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONFieldTag(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name  string
+		field *ast.Field
+		want  string
+	}{
+		{
+			name: "success: no json tag uses field name",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "userName"}},
+				Tag:   &ast.BasicLit{Value: "`property:\"get\"`"},
+			},
+			want: "`json:\"userName\"`",
+		},
+		{
+			name: "success: json tag name",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "userName"}},
+				Tag:   &ast.BasicLit{Value: "`property:\"get\" json:\"user_name\"`"},
+			},
+			want: "`json:\"user_name\"`",
+		},
+		{
+			name: "success: json tag options without name",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "userName"}},
+				Tag:   &ast.BasicLit{Value: "`property:\"get\" json:\",omitempty\"`"},
+			},
+			want: "`json:\"userName,omitempty\"`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, generator.jsonFieldTag(tt.field))
+		})
+	}
+}
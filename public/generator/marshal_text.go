@@ -0,0 +1,253 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"github.com/hidori/go-astutil"
+	"github.com/hidori/go-typeutil"
+	"github.com/pkg/errors"
+)
+
+func (g *Generator) textFuncDecls(structName string, fieldList *ast.FieldList) ([]ast.Decl, error) {
+	fields := g.propertyFields(fieldList, nil)
+	if len(fields) != 1 {
+		return nil, errors.Wrapf(errInvalidTypeDirective, "marshal=text requires exactly one property field: type=%s", structName)
+	}
+
+	field := fields[0]
+
+	if !isIdentType(field.Type, "string") && !g.isTextScannableType(field.Type) && !g.isTextMarshalerCandidate(field.Type) {
+		return nil, errors.Wrapf(errInvalidFieldType, "marshal=text does not support field: type=%s field=%s", structName, field.Names[0].Name)
+	}
+
+	return []ast.Decl{
+		&ast.FuncDecl{
+			Recv: g.buildRecvFieldList(structName),
+			Name: astutil.NewIdent("MarshalText"),
+			Type: g.buildMarshalFuncType(),
+			Body: astutil.NewBlockStmt(g.buildMarshalTextStmts(field)),
+		},
+		&ast.FuncDecl{
+			Recv: g.buildRecvFieldList(structName),
+			Name: astutil.NewIdent("UnmarshalText"),
+			Type: g.buildUnmarshalFuncType(),
+			Body: astutil.NewBlockStmt(g.buildUnmarshalTextStmts(field)),
+		},
+	}, nil
+}
+
+func (g *Generator) isTextScannableType(fieldType ast.Expr) bool {
+	return isIdentType(fieldType, "bool") || (isNumericType(fieldType) && !isDurationType(fieldType))
+}
+
+func (g *Generator) isTextMarshalerCandidate(fieldType ast.Expr) bool {
+	if isDurationType(fieldType) {
+		return false
+	}
+
+	if ident := typeutil.AsOrEmpty[*ast.Ident](fieldType); ident != nil {
+		return ast.IsExported(ident.Name)
+	}
+
+	return typeutil.AsOrEmpty[*ast.SelectorExpr](fieldType) != nil
+}
+
+func (g *Generator) buildMarshalTextStmts(field *ast.Field) []ast.Stmt {
+	selectorExpr := astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name))
+
+	switch {
+	case isIdentType(field.Type, "string"):
+		return []ast.Stmt{
+			astutil.NewReturnStmt(
+				[]ast.Expr{
+					g.buildBytesConversionExpr(selectorExpr),
+					astutil.NewIdent("nil"),
+				},
+			),
+		}
+
+	case g.isTextScannableType(field.Type):
+		return []ast.Stmt{
+			astutil.NewReturnStmt(
+				[]ast.Expr{
+					g.buildBytesConversionExpr(&ast.CallExpr{
+						Fun:  astutil.NewSelectorExpr(astutil.NewIdent("fmt"), astutil.NewIdent("Sprint")),
+						Args: []ast.Expr{selectorExpr},
+					}),
+					astutil.NewIdent("nil"),
+				},
+			),
+		}
+
+	default:
+		return []ast.Stmt{
+			astutil.NewReturnStmt(
+				[]ast.Expr{
+					&ast.CallExpr{
+						Fun: astutil.NewSelectorExpr(selectorExpr, astutil.NewIdent("MarshalText")),
+					},
+				},
+			),
+		}
+	}
+}
+
+func (g *Generator) buildUnmarshalTextStmts(field *ast.Field) []ast.Stmt {
+	var stmts []ast.Stmt
+
+	switch {
+	case isIdentType(field.Type, "string"):
+		stmts = append(stmts, astutil.NewAssignStmt(
+			[]ast.Expr{
+				astutil.NewIdent("v"),
+			},
+			token.DEFINE,
+			[]ast.Expr{
+				&ast.CallExpr{
+					Fun:  astutil.NewIdent("string"),
+					Args: []ast.Expr{astutil.NewIdent("data")},
+				},
+			},
+		))
+
+	case g.isTextScannableType(field.Type):
+		stmts = append(stmts, g.buildParseTextStmts(field.Type)...)
+
+	default:
+		stmts = append(stmts,
+			g.buildVarStmt("v", field.Type),
+			g.buildReturnIfErrStmt(&ast.CallExpr{
+				Fun:  astutil.NewSelectorExpr(astutil.NewIdent("v"), astutil.NewIdent("UnmarshalText")),
+				Args: []ast.Expr{astutil.NewIdent("data")},
+			}),
+		)
+	}
+
+	setterName, ok := g.setterNameOf(field)
+	if ok && g.hasValidation(field) {
+		return append(stmts, astutil.NewReturnStmt(
+			[]ast.Expr{
+				&ast.CallExpr{
+					Fun:  astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(setterName)),
+					Args: []ast.Expr{astutil.NewIdent("v")},
+				},
+			},
+		))
+	}
+
+	return append(stmts, g.buildAssignFieldStmt(field, astutil.NewIdent("v")), astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("nil")}))
+}
+
+// buildParseTextStmts parses data into v with strconv, which rejects trailing input unlike fmt.Sscan.
+// Values of types other than the result type of the parse function are converted with an intermediate n.
+func (g *Generator) buildParseTextStmts(fieldType ast.Expr) []ast.Stmt {
+	ident := typeutil.AsOrEmpty[*ast.Ident](fieldType)
+	dataExpr := &ast.CallExpr{
+		Fun:  astutil.NewIdent("string"),
+		Args: []ast.Expr{astutil.NewIdent("data")},
+	}
+
+	var (
+		funcName   string
+		args       []ast.Expr
+		resultType string
+	)
+
+	switch {
+	case ident.Name == "bool":
+		funcName, args, resultType = "ParseBool", []ast.Expr{dataExpr}, "bool"
+
+	case floatBitSizes[ident.Name] > 0:
+		funcName, resultType = "ParseFloat", "float64"
+		args = []ast.Expr{dataExpr, astutil.NewBasicLit(token.INT, strconv.Itoa(floatBitSizes[ident.Name]))}
+
+	case uintBitSizes[ident.Name] > 0:
+		funcName, resultType = "ParseUint", "uint64"
+		args = []ast.Expr{dataExpr, astutil.NewBasicLit(token.INT, "10"), astutil.NewBasicLit(token.INT, parseBitSizeOf(ident.Name, uintBitSizes))}
+
+	default:
+		funcName, resultType = "ParseInt", "int64"
+		args = []ast.Expr{dataExpr, astutil.NewBasicLit(token.INT, "10"), astutil.NewBasicLit(token.INT, parseBitSizeOf(ident.Name, intBitSizes))}
+	}
+
+	name := "v"
+	if ident.Name != resultType {
+		name = "n"
+	}
+
+	stmts := []ast.Stmt{
+		astutil.NewAssignStmt(
+			[]ast.Expr{astutil.NewIdent(name), astutil.NewIdent("err")},
+			token.DEFINE,
+			[]ast.Expr{
+				&ast.CallExpr{
+					Fun:  astutil.NewSelectorExpr(astutil.NewIdent("strconv"), astutil.NewIdent(funcName)),
+					Args: args,
+				},
+			},
+		),
+		&ast.IfStmt{
+			Cond: &ast.BinaryExpr{
+				Op: token.NEQ,
+				X:  astutil.NewIdent("err"),
+				Y:  astutil.NewIdent("nil"),
+			},
+			Body: astutil.NewBlockStmt(
+				[]ast.Stmt{
+					astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("err")}),
+				},
+			),
+		},
+	}
+
+	if name == "n" {
+		stmts = append(stmts, astutil.NewAssignStmt(
+			[]ast.Expr{astutil.NewIdent("v")},
+			token.DEFINE,
+			[]ast.Expr{
+				&ast.CallExpr{
+					Fun:  astutil.NewIdent(ident.Name),
+					Args: []ast.Expr{astutil.NewIdent("n")},
+				},
+			},
+		))
+	}
+
+	return stmts
+}
+
+// parseBitSizeOf returns the bit size argument of strconv, which is 0 for the platform dependent int, uint and uintptr.
+func parseBitSizeOf(typeName string, bitSizes map[string]int) string {
+	switch typeName {
+	case "int", "uint", "uintptr":
+		return "0"
+
+	default:
+		return strconv.Itoa(bitSizes[typeName])
+	}
+}
+
+func (g *Generator) buildBytesConversionExpr(x ast.Expr) ast.Expr {
+	return &ast.CallExpr{
+		Fun:  &ast.ArrayType{Elt: astutil.NewIdent("byte")},
+		Args: []ast.Expr{x},
+	}
+}
+
+func (g *Generator) buildVarStmt(name string, varType ast.Expr) ast.Stmt {
+	return &ast.DeclStmt{
+		Decl: &ast.GenDecl{
+			Tok: token.VAR,
+			Specs: []ast.Spec{
+				&ast.ValueSpec{
+					Names: []*ast.Ident{
+						astutil.NewIdent(name),
+					},
+					Type: varType,
+				},
+			},
+		},
+	}
+}
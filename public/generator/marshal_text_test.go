@@ -0,0 +1,81 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTextFuncDecls(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	newFieldList := func(fieldTypes ...ast.Expr) *ast.FieldList {
+		fieldList := &ast.FieldList{}
+
+		for _, fieldType := range fieldTypes {
+			fieldList.List = append(fieldList.List, &ast.Field{
+				Names: []*ast.Ident{{Name: "value"}},
+				Type:  fieldType,
+				Tag:   &ast.BasicLit{Value: "`property:\"get\"`"},
+			})
+		}
+
+		return fieldList
+	}
+
+	tests := []struct {
+		name      string
+		fieldList *ast.FieldList
+		wantErr   error
+	}{
+		{
+			name:      "success: string field",
+			fieldList: newFieldList(&ast.Ident{Name: "string"}),
+		},
+		{
+			name:      "success: numeric field",
+			fieldList: newFieldList(&ast.Ident{Name: "int"}),
+		},
+		{
+			name:      "success: text marshaler candidate field",
+			fieldList: newFieldList(&ast.SelectorExpr{X: &ast.Ident{Name: "time"}, Sel: &ast.Ident{Name: "Time"}}),
+		},
+		{
+			name:      "failure: no field",
+			fieldList: newFieldList(),
+			wantErr:   errInvalidTypeDirective,
+		},
+		{
+			name:      "failure: time.Duration field",
+			fieldList: newFieldList(&ast.SelectorExpr{X: &ast.Ident{Name: "time"}, Sel: &ast.Ident{Name: "Duration"}}),
+			wantErr:   errInvalidFieldType,
+		},
+		{
+			name:      "failure: slice field",
+			fieldList: newFieldList(&ast.ArrayType{Elt: &ast.Ident{Name: "string"}}),
+			wantErr:   errInvalidFieldType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decls, err := generator.textFuncDecls("TestStruct", tt.fieldList)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, decls, 2)
+		})
+	}
+}
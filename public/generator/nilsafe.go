@@ -0,0 +1,59 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/hidori/go-astutil"
+)
+
+func (g *Generator) buildNilReceiverStmt(fieldType ast.Expr, defaultExpr ast.Expr) ast.Stmt {
+	if defaultExpr != nil {
+		return &ast.IfStmt{
+			Cond: g.buildNilReceiverExpr(),
+			Body: astutil.NewBlockStmt(
+				[]ast.Stmt{
+					astutil.NewReturnStmt(
+						[]ast.Expr{
+							defaultExpr,
+						},
+					),
+				},
+			),
+		}
+	}
+
+	return &ast.IfStmt{
+		Cond: g.buildNilReceiverExpr(),
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				&ast.DeclStmt{
+					Decl: &ast.GenDecl{
+						Tok: token.VAR,
+						Specs: []ast.Spec{
+							&ast.ValueSpec{
+								Names: []*ast.Ident{
+									astutil.NewIdent("zero"),
+								},
+								Type: fieldType,
+							},
+						},
+					},
+				},
+				astutil.NewReturnStmt(
+					[]ast.Expr{
+						astutil.NewIdent("zero"),
+					},
+				),
+			},
+		),
+	}
+}
+
+func (g *Generator) buildNilReceiverExpr() ast.Expr {
+	return &ast.BinaryExpr{
+		Op: token.EQL,
+		X:  g.recvIdent(),
+		Y:  astutil.NewIdent("nil"),
+	}
+}
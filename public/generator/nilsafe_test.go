@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildNilReceiverStmt(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name        string
+		fieldType   ast.Expr
+		defaultExpr ast.Expr
+		wantLen     int
+	}{
+		{
+			name:      "success: ident type",
+			fieldType: &ast.Ident{Name: "string"},
+			wantLen:   2,
+		},
+		{
+			name:      "success: pointer type",
+			fieldType: &ast.StarExpr{X: &ast.Ident{Name: "string"}},
+			wantLen:   2,
+		},
+		{
+			name:        "success: default value",
+			fieldType:   &ast.Ident{Name: "int"},
+			defaultExpr: &ast.BasicLit{Kind: token.INT, Value: "8080"},
+			wantLen:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			stmt := generator.buildNilReceiverStmt(tt.fieldType, tt.defaultExpr)
+
+			ifStmt, ok := stmt.(*ast.IfStmt)
+			require.True(t, ok)
+			assert.Len(t, ifStmt.Body.List, tt.wantLen)
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/hidori/go-astutil"
+	"github.com/hidori/go-typeutil"
+	"github.com/pkg/errors"
+)
+
+var errInvalidFieldType = errors.New("invalid field type")
+
+func (g *Generator) optionalFuncDecls(structName string, field *ast.Field) ([]ast.Decl, error) {
+	if len(field.Names) == 0 {
+		return []ast.Decl{}, nil
+	}
+
+	starExpr := typeutil.AsOrEmpty[*ast.StarExpr](field.Type)
+	if starExpr == nil {
+		return nil, errors.Wrapf(errInvalidFieldType, "optional field must be a pointer: field=%s", field.Names[0].Name)
+	}
+
+	return []ast.Decl{
+		g.hasFuncDecl(structName, field),
+		g.clearFuncDecl(structName, field),
+		g.getOrFuncDecl(structName, field, starExpr.X),
+		g.setValueFuncDecl(structName, field, starExpr.X),
+	}, nil
+}
+
+func (g *Generator) hasFuncDecl(structName string, field *ast.Field) ast.Decl {
+	name := "Has" + g.prepareFieldName(field.Names[0].Name)
+
+	return &ast.FuncDecl{
+		Doc:  accessorDoc(name, fmt.Sprintf("reports whether the %s is set.", field.Names[0].Name), field),
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent(name),
+		Type: astutil.NewFuncType(
+			nil,
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(nil, astutil.NewIdent("bool")),
+				},
+			),
+		),
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				astutil.NewReturnStmt(
+					[]ast.Expr{
+						g.buildFieldNotNilExpr(field),
+					},
+				),
+			},
+		),
+	}
+}
+
+func (g *Generator) clearFuncDecl(structName string, field *ast.Field) ast.Decl {
+	name := "Clear" + g.prepareFieldName(field.Names[0].Name)
+
+	return &ast.FuncDecl{
+		Doc:  accessorDoc(name, fmt.Sprintf("clears the %s.", field.Names[0].Name), field),
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent(name),
+		Type: astutil.NewFuncType(nil, nil, nil),
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				astutil.NewAssignStmt(
+					[]ast.Expr{
+						astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name)),
+					},
+					token.ASSIGN,
+					[]ast.Expr{
+						astutil.NewIdent("nil"),
+					},
+				),
+			},
+		),
+	}
+}
+
+func (g *Generator) getOrFuncDecl(structName string, field *ast.Field, elemType ast.Expr) ast.Decl {
+	name := "Get" + g.prepareFieldName(field.Names[0].Name) + "Or"
+
+	return &ast.FuncDecl{
+		Doc:  accessorDoc(name, fmt.Sprintf("returns the %s, or def when it is not set.", field.Names[0].Name), field),
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent(name),
+		Type: astutil.NewFuncType(
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(
+						[]*ast.Ident{
+							astutil.NewIdent("def"),
+						},
+						elemType,
+					),
+				},
+			),
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(nil, elemType),
+				},
+			),
+		),
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				&ast.IfStmt{
+					Cond: g.buildFieldNotNilExpr(field),
+					Body: astutil.NewBlockStmt(
+						[]ast.Stmt{
+							astutil.NewReturnStmt(
+								[]ast.Expr{
+									astutil.NewStarExpr(
+										astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name)),
+									),
+								},
+							),
+						},
+					),
+				},
+				astutil.NewReturnStmt(
+					[]ast.Expr{
+						astutil.NewIdent("def"),
+					},
+				),
+			},
+		),
+	}
+}
+
+// setValueFuncDecl returns Set<Field>Value, which sets the field to a pointer to the value.
+// When the field has a setter, the value goes through the setter, so that its validation and hooks run,
+// and Set<Field>Value is private when the setter is.
+func (g *Generator) setValueFuncDecl(structName string, field *ast.Field, elemType ast.Expr) ast.Decl {
+	fieldName := field.Names[0].Name
+	ptrExpr := &ast.UnaryExpr{
+		Op: token.AND,
+		X:  astutil.NewIdent("v"),
+	}
+
+	verb := "Set"
+	body := []ast.Stmt{
+		astutil.NewAssignStmt(
+			[]ast.Expr{
+				astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(fieldName)),
+			},
+			token.ASSIGN,
+			[]ast.Expr{
+				ptrExpr,
+			},
+		),
+	}
+
+	var results *ast.FieldList
+
+	if setterName, ok := g.setterNameOf(field); ok {
+		if g.hasDirective(field, "set=private") && !g.hasDirective(field, "set") {
+			verb = "set"
+		}
+
+		callExpr := &ast.CallExpr{
+			Fun:  astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(setterName)),
+			Args: []ast.Expr{ptrExpr},
+		}
+
+		body = []ast.Stmt{&ast.ExprStmt{X: callExpr}}
+
+		if g.hasValidation(field) {
+			results = astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(nil, astutil.NewIdent("error")),
+				},
+			)
+			body = []ast.Stmt{astutil.NewReturnStmt([]ast.Expr{callExpr})}
+		}
+	}
+
+	name := verb + g.prepareFieldName(fieldName) + "Value"
+
+	return &ast.FuncDecl{
+		Doc:  accessorDoc(name, fmt.Sprintf("sets the %s to a pointer to v.", fieldName), field),
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent(name),
+		Type: astutil.NewFuncType(
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(
+						[]*ast.Ident{
+							astutil.NewIdent("v"),
+						},
+						elemType,
+					),
+				},
+			),
+			results,
+		),
+		Body: astutil.NewBlockStmt(body),
+	}
+}
+
+func (g *Generator) buildFieldNotNilExpr(field *ast.Field) ast.Expr {
+	return &ast.BinaryExpr{
+		Op: token.NEQ,
+		X:  astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name)),
+		Y:  astutil.NewIdent("nil"),
+	}
+}
@@ -0,0 +1,75 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionalFuncDecls(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name      string
+		field     *ast.Field
+		wantNames []string
+		wantErr   bool
+	}{
+		{
+			name: "success: pointer field returns helpers",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "nickname"}},
+				Type:  &ast.StarExpr{X: &ast.Ident{Name: "string"}},
+			},
+			wantNames: []string{"HasNickname", "ClearNickname", "GetNicknameOr", "SetNicknameValue"},
+		},
+		{
+			name: "success: anonymous field returns empty",
+			field: &ast.Field{
+				Names: []*ast.Ident{},
+				Type:  &ast.StarExpr{X: &ast.Ident{Name: "string"}},
+			},
+			wantNames: []string{},
+		},
+		{
+			name: "failure: non-pointer field",
+			field: &ast.Field{
+				Names: []*ast.Ident{{Name: "nickname"}},
+				Type:  &ast.Ident{Name: "string"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decls, err := generator.optionalFuncDecls("TestStruct", tt.field)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errInvalidFieldType)
+				return
+			}
+
+			require.NoError(t, err)
+
+			names := []string{}
+
+			for _, decl := range decls {
+				funcDecl, ok := decl.(*ast.FuncDecl)
+				require.True(t, ok)
+
+				names = append(names, funcDecl.Name.Name)
+			}
+
+			assert.Equal(t, tt.wantNames, names)
+		})
+	}
+}
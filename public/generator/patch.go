@@ -0,0 +1,90 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/hidori/go-astutil"
+	"github.com/pkg/errors"
+)
+
+func (g *Generator) patchDecls(structName string, fieldList *ast.FieldList) ([]ast.Decl, error) {
+	fields := g.propertyFields(fieldList, func(field *ast.Field) bool {
+		return g.hasDirective(field, "set")
+	})
+	if len(fields) < 1 {
+		return nil, errors.Wrapf(errInvalidTypeDirective, "patch requires fields with public setters: type=%s", structName)
+	}
+
+	patchName := structName + "Patch"
+
+	var patchFields []*ast.Field
+
+	for _, field := range fields {
+		patchFields = append(patchFields, astutil.NewField(
+			[]*ast.Ident{
+				astutil.NewIdent(g.prepareFieldName(field.Names[0].Name)),
+			},
+			astutil.NewStarExpr(field.Type),
+		))
+	}
+
+	return []ast.Decl{
+		&ast.GenDecl{
+			Tok: token.TYPE,
+			Specs: []ast.Spec{
+				&ast.TypeSpec{
+					Name: astutil.NewIdent(patchName),
+					Type: &ast.StructType{
+						Fields: astutil.NewFieldList(patchFields),
+					},
+				},
+			},
+		},
+		g.applyPatchFuncDecl(structName, patchName, fields),
+	}, nil
+}
+
+func (g *Generator) applyPatchFuncDecl(structName string, patchName string, fields []*ast.Field) ast.Decl {
+	valueExprOf := func(field *ast.Field) ast.Expr {
+		return astutil.NewSelectorExpr(astutil.NewIdent("p"), astutil.NewIdent(g.prepareFieldName(field.Names[0].Name)))
+	}
+
+	stmts := g.buildAssignFieldsStmts(
+		fields,
+		func(field *ast.Field) ast.Expr {
+			return astutil.NewStarExpr(valueExprOf(field))
+		},
+		func(field *ast.Field) ast.Expr {
+			return &ast.BinaryExpr{
+				Op: token.NEQ,
+				X:  valueExprOf(field),
+				Y:  astutil.NewIdent("nil"),
+			}
+		},
+	)
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("ApplyPatch"),
+		Type: astutil.NewFuncType(
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(
+						[]*ast.Ident{
+							astutil.NewIdent("p"),
+						},
+						astutil.NewIdent(patchName),
+					),
+				},
+			),
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(nil, astutil.NewIdent("error")),
+				},
+			),
+		),
+		Body: astutil.NewBlockStmt(stmts),
+	}
+}
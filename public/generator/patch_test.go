@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchDecls(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name       string
+		tag        string
+		wantFields []string
+		wantErr    bool
+	}{
+		{
+			name:       "success: public setter",
+			tag:        "`property:\"get,set\"`",
+			wantFields: []string{"Value"},
+		},
+		{
+			name:    "failure: private setter",
+			tag:     "`property:\"set=private\"`",
+			wantErr: true,
+		},
+		{
+			name:    "failure: no setters",
+			tag:     "`property:\"get\"`",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fieldList := &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{{Name: "value"}},
+						Type:  &ast.Ident{Name: "string"},
+						Tag:   &ast.BasicLit{Value: tt.tag},
+					},
+				},
+			}
+
+			decls, err := generator.patchDecls("TestStruct", fieldList)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errInvalidTypeDirective)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, decls, 2)
+
+			typeSpec := decls[0].(*ast.GenDecl).Specs[0].(*ast.TypeSpec)
+			assert.Equal(t, "TestStructPatch", typeSpec.Name.Name)
+
+			var names []string
+
+			for _, field := range typeSpec.Type.(*ast.StructType).Fields.List {
+				names = append(names, field.Names[0].Name)
+				assert.IsType(t, &ast.StarExpr{}, field.Type)
+			}
+
+			assert.Equal(t, tt.wantFields, names)
+		})
+	}
+}
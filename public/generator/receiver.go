@@ -0,0 +1,263 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/hidori/go-astutil"
+	"github.com/hidori/go-typeutil"
+	"github.com/pkg/errors"
+)
+
+const defaultReceiverName = "t"
+
+// reservedReceiverNames are the package names used by the generated code, which can not be used as receiver names.
+// Local variables of the generated code that clash with the receiver name are renamed instead.
+var reservedReceiverNames = map[string]bool{
+	"_": true, "bytes": true, "cmp": true, "errors": true, "fmt": true, "gob": true, "json": true, "maps": true,
+	"slices": true, "slog": true, "strconv": true, "strings": true, "time": true,
+}
+
+var (
+	errInvalidReceiverName = errors.New("invalid receiver name")
+	errValueReceiver       = errors.New("value receiver is not allowed")
+)
+
+func isReceiverName(name string) bool {
+	return token.IsIdentifier(name) && !reservedReceiverNames[name]
+}
+
+// receiversOf returns the receiver names used by the methods declared in the files, keyed by the receiver type name.
+// The first named receiver of each type wins, and blank receivers and generated files are skipped.
+func receiversOf(files ...*ast.File) map[string]string {
+	receivers := map[string]string{}
+
+	for _, file := range files {
+		if ast.IsGenerated(file) {
+			continue
+		}
+
+		for _, d := range file.Decls {
+			funcDecl := typeutil.AsOrEmpty[*ast.FuncDecl](d)
+			if funcDecl == nil || funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 {
+				continue
+			}
+
+			field := funcDecl.Recv.List[0]
+			if len(field.Names) == 0 || field.Names[0].Name == "_" {
+				continue
+			}
+
+			typeName := receiverTypeNameOf(field.Type)
+			if _, ok := receivers[typeName]; typeName == "" || ok {
+				continue
+			}
+
+			receivers[typeName] = field.Names[0].Name
+		}
+	}
+
+	return receivers
+}
+
+func receiverTypeNameOf(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeNameOf(e.X)
+
+	case *ast.IndexExpr:
+		return receiverTypeNameOf(e.X)
+
+	case *ast.IndexListExpr:
+		return receiverTypeNameOf(e.X)
+
+	case *ast.Ident:
+		return e.Name
+
+	default:
+		return ""
+	}
+}
+
+// forType returns a copy of the generator that generates the methods of the given struct.
+// The receiver name is taken from the configuration, then from the existing methods of the struct in the package,
+// and falls back to "t" when neither is usable.
+func (g *Generator) forType(structName string, fieldList *ast.FieldList, directives typeDirectives) (*Generator, error) {
+	gen := *g
+
+	gen.receiver = defaultReceiverName
+	gen.recvIdents = map[*ast.Ident]bool{}
+
+	switch {
+	case g.config.Receiver != "":
+		gen.receiver = g.config.Receiver
+
+	case isReceiverName(g.receivers[structName]):
+		gen.receiver = g.receivers[structName]
+	}
+
+	gen.valueGetters = directives.has("getter", "value")
+
+	if gen.valueGetters {
+		for _, field := range fieldList.List {
+			_, lazy := g.directiveValue(field, "lazy")
+			if isSyncType(field.Type) || lazy {
+				return nil, errors.Wrapf(errValueReceiver, "struct=%s fields=%v", structName, fieldNamesOf(field))
+			}
+		}
+	}
+
+	return &gen, nil
+}
+
+func (g *Generator) recvIdent() *ast.Ident {
+	if g.receiver == "" {
+		return astutil.NewIdent(defaultReceiverName)
+	}
+
+	ident := astutil.NewIdent(g.receiver)
+
+	if g.recvIdents != nil {
+		g.recvIdents[ident] = true
+	}
+
+	return ident
+}
+
+// renameLocals renames the local variables and parameters of the generated functions that clash with the receiver name,
+// such as the parameter v of setters when the receiver is named v.
+func (g *Generator) renameLocals(decls []ast.Decl) {
+	for _, d := range decls {
+		funcDecl := typeutil.AsOrEmpty[*ast.FuncDecl](d)
+		if funcDecl == nil || !g.declaresLocal(funcDecl, g.receiver) {
+			continue
+		}
+
+		names := map[string]bool{}
+
+		ast.Inspect(funcDecl, func(node ast.Node) bool {
+			if ident, ok := node.(*ast.Ident); ok {
+				names[ident.Name] = true
+			}
+
+			return true
+		})
+
+		newName := g.receiver
+		for i := 1; names[newName]; i++ {
+			newName = fmt.Sprintf("%s%d", g.receiver, i)
+		}
+
+		ast.Inspect(funcDecl.Type, g.renameIdents(newName))
+		ast.Inspect(funcDecl.Body, g.renameIdents(newName))
+	}
+}
+
+// declaresLocal reports whether the parameters, results or body of the function declare the given name.
+func (g *Generator) declaresLocal(funcDecl *ast.FuncDecl, name string) bool {
+	found := false
+
+	declares := func(exprs ...ast.Expr) {
+		for _, expr := range exprs {
+			if ident := typeutil.AsOrEmpty[*ast.Ident](expr); ident != nil && ident.Name == name && !g.recvIdents[ident] {
+				found = true
+			}
+		}
+	}
+
+	declaresFields := func(fieldList *ast.FieldList) {
+		if fieldList == nil {
+			return
+		}
+
+		for _, field := range fieldList.List {
+			for _, ident := range field.Names {
+				declares(ident)
+			}
+		}
+	}
+
+	declaresFields(funcDecl.Type.Params)
+	declaresFields(funcDecl.Type.Results)
+
+	ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE {
+				declares(n.Lhs...)
+			}
+
+		case *ast.RangeStmt:
+			if n.Tok == token.DEFINE {
+				declares(n.Key, n.Value)
+			}
+
+		case *ast.ValueSpec:
+			for _, ident := range n.Names {
+				declares(ident)
+			}
+
+		case *ast.FuncLit:
+			declaresFields(n.Type.Params)
+			declaresFields(n.Type.Results)
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// renameIdents returns an ast.Inspect visitor that renames the identifiers named as the receiver to the given name,
+// leaving the receiver itself, field selectors, keys of composite literals and struct fields unchanged.
+func (g *Generator) renameIdents(newName string) func(node ast.Node) bool {
+	var visit func(node ast.Node) bool
+
+	visit = func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.SelectorExpr:
+			ast.Inspect(n.X, visit)
+
+			return false
+
+		case *ast.KeyValueExpr:
+			if typeutil.AsOrEmpty[*ast.Ident](n.Key) == nil {
+				ast.Inspect(n.Key, visit)
+			}
+
+			ast.Inspect(n.Value, visit)
+
+			return false
+
+		case *ast.StructType:
+			return false
+
+		case *ast.Ident:
+			if n.Name == g.receiver && !g.recvIdents[n] {
+				n.Name = newName
+			}
+		}
+
+		return true
+	}
+
+	return visit
+}
+
+func (g *Generator) buildGetterRecvFieldList(structName string) *ast.FieldList {
+	if !g.valueGetters {
+		return g.buildRecvFieldList(structName)
+	}
+
+	return astutil.NewFieldList(
+		[]*ast.Field{
+			astutil.NewField(
+				[]*ast.Ident{
+					g.recvIdent(),
+				},
+				astutil.NewIdent(structName),
+			),
+		},
+	)
+}
@@ -0,0 +1,236 @@
+package generator
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceiversOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		srcs []string
+		want map[string]string
+	}{
+		{
+			name: "success: pointer and value receivers",
+			srcs: []string{`package data
+func (u *User) FullName() string { return "" }
+func (p Point) Len() int { return 0 }
+`},
+			want: map[string]string{"User": "u", "Point": "p"},
+		},
+		{
+			name: "success: first named receiver wins",
+			srcs: []string{`package data
+func (*User) Kind() string { return "" }
+func (_ *User) Name() string { return "" }
+func (usr *User) FullName() string { return "" }
+func (u *User) Email() string { return "" }
+`},
+			want: map[string]string{"User": "usr"},
+		},
+		{
+			name: "success: generic receiver",
+			srcs: []string{`package data
+func (l *List[T]) Len() int { return 0 }
+func (m *Map[K, V]) Len() int { return 0 }
+`},
+			want: map[string]string{"List": "l", "Map": "m"},
+		},
+		{
+			name: "success: methods in other files",
+			srcs: []string{`package data
+type User struct{}
+`, `package data
+func (u *User) FullName() string { return "" }
+`, `// Code generated by genprop DO NOT EDIT.
+
+package data
+func (t *Point) Len() int { return 0 }
+`},
+			want: map[string]string{"User": "u"},
+		},
+		{
+			name: "success: functions are ignored",
+			srcs: []string{`package data
+func NewUser() *User { return nil }
+`},
+			want: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var files []*ast.File
+
+			for _, src := range tt.srcs {
+				file, err := parser.ParseFile(token.NewFileSet(), "", src, parser.AllErrors|parser.ParseComments)
+				require.NoError(t, err)
+
+				files = append(files, file)
+			}
+
+			assert.Equal(t, tt.want, receiversOf(files...))
+		})
+	}
+}
+
+func TestIsReceiverName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		receiverName string
+		want         bool
+	}{
+		{
+			name:         "success: identifier",
+			receiverName: "u",
+			want:         true,
+		},
+		{
+			name:         "failure: blank identifier",
+			receiverName: "_",
+			want:         false,
+		},
+		{
+			name:         "success: name of a generated local",
+			receiverName: "v",
+			want:         true,
+		},
+		{
+			name:         "failure: package name",
+			receiverName: "strings",
+			want:         false,
+		},
+		{
+			name:         "failure: not an identifier",
+			receiverName: "1u",
+			want:         false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, isReceiverName(tt.receiverName))
+		})
+	}
+}
+
+func TestForType(t *testing.T) {
+	t.Parallel()
+
+	fieldListOf := func(tag string, fieldType ast.Expr) *ast.FieldList {
+		return &ast.FieldList{
+			List: []*ast.Field{
+				{
+					Names: []*ast.Ident{{Name: "value"}},
+					Type:  fieldType,
+					Tag:   &ast.BasicLit{Kind: token.STRING, Value: tag},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		receiver         string
+		receivers        map[string]string
+		fieldList        *ast.FieldList
+		directives       typeDirectives
+		wantReceiver     string
+		wantValueGetters bool
+		wantErr          bool
+	}{
+		{
+			name:         "success: default receiver",
+			fieldList:    fieldListOf("`property:\"get\"`", &ast.Ident{Name: "int"}),
+			directives:   typeDirectives{},
+			wantReceiver: "t",
+		},
+		{
+			name:         "success: detected receiver",
+			receivers:    map[string]string{"TestStruct": "ts"},
+			fieldList:    fieldListOf("`property:\"get\"`", &ast.Ident{Name: "int"}),
+			directives:   typeDirectives{},
+			wantReceiver: "ts",
+		},
+		{
+			name:         "success: detected receiver clashing with a local",
+			receivers:    map[string]string{"TestStruct": "v"},
+			fieldList:    fieldListOf("`property:\"get\"`", &ast.Ident{Name: "int"}),
+			directives:   typeDirectives{},
+			wantReceiver: "v",
+		},
+		{
+			name:         "success: detected receiver clashing with a package falls back to default",
+			receivers:    map[string]string{"TestStruct": "strings"},
+			fieldList:    fieldListOf("`property:\"get\"`", &ast.Ident{Name: "int"}),
+			directives:   typeDirectives{},
+			wantReceiver: "t",
+		},
+		{
+			name:         "success: configured receiver wins",
+			receiver:     "x",
+			receivers:    map[string]string{"TestStruct": "ts"},
+			fieldList:    fieldListOf("`property:\"get\"`", &ast.Ident{Name: "int"}),
+			directives:   typeDirectives{},
+			wantReceiver: "x",
+		},
+		{
+			name:             "success: value getters",
+			fieldList:        fieldListOf("`property:\"get\"`", &ast.Ident{Name: "int"}),
+			directives:       typeDirectives{"getter": {"value"}},
+			wantReceiver:     "t",
+			wantValueGetters: true,
+		},
+		{
+			name:       "failure: value getters with lazy getter",
+			fieldList:  fieldListOf("`property:\"get,lazy=buildValue\"`", &ast.Ident{Name: "int"}),
+			directives: typeDirectives{"getter": {"value"}},
+			wantErr:    true,
+		},
+		{
+			name:       "failure: value getters with sync field",
+			fieldList:  fieldListOf("``", &ast.SelectorExpr{X: &ast.Ident{Name: "sync"}, Sel: &ast.Ident{Name: "Mutex"}}),
+			directives: typeDirectives{"getter": {"value"}},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			generator := &Generator{
+				config: &GeneratorConfig{
+					TagName:  tagName,
+					Receiver: tt.receiver,
+				},
+				receivers: tt.receivers,
+			}
+
+			got, err := generator.forType("TestStruct", tt.fieldList, tt.directives)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errValueReceiver)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantReceiver, got.recvIdent().Name)
+			assert.Equal(t, tt.wantValueGetters, got.valueGetters)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/hidori/go-astutil"
+	"github.com/hidori/go-typeutil"
+)
+
+func (g *Generator) resetFuncDecl(structName string, field *ast.Field) ast.Decl {
+	fieldExpr := astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name))
+
+	var stmts []ast.Stmt
+
+	valueExpr := g.defaultValueExprOf(field)
+	if valueExpr == nil {
+		valueExpr = g.zeroValueExpr(field.Type)
+	}
+
+	if valueExpr == nil && isNillableType(field.Type) {
+		valueExpr = astutil.NewIdent("nil")
+	}
+
+	if valueExpr == nil {
+		stmts = append(stmts, g.buildVarStmt("zero", field.Type))
+		valueExpr = astutil.NewIdent("zero")
+	}
+
+	stmts = append(stmts, astutil.NewAssignStmt([]ast.Expr{fieldExpr}, token.ASSIGN, []ast.Expr{valueExpr}))
+
+	name := "Reset" + g.prepareFieldName(field.Names[0].Name)
+
+	return &ast.FuncDecl{
+		Doc:  accessorDoc(name, fmt.Sprintf("restores the default or zero value of the %s.", field.Names[0].Name), field),
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent(name),
+		Type: astutil.NewFuncType(nil, nil, nil),
+		Body: astutil.NewBlockStmt(stmts),
+	}
+}
+
+// resetAllFuncDecl returns Reset() that restores every field to its default or zero value.
+// Lock fields of the sync package and pointers to them are preserved,
+// and sync.Once fields are renewed so that lazy getters run again.
+func (g *Generator) resetAllFuncDecl(structName string, fieldList *ast.FieldList) ast.Decl {
+	var stmts []ast.Stmt
+
+	usesZero := false
+
+	for _, field := range fieldList.List {
+		syncType := field.Type
+		if starExpr := typeutil.AsOrEmpty[*ast.StarExpr](field.Type); starExpr != nil {
+			syncType = starExpr.X
+		}
+
+		if isSyncType(syncType) && !isSyncOnceType(syncType) {
+			continue
+		}
+
+		for _, name := range fieldNamesOf(field) {
+			var valueExpr ast.Expr
+
+			switch {
+			case isSyncOnceType(field.Type):
+				valueExpr = &ast.CompositeLit{Type: field.Type}
+
+			case isSyncOnceType(syncType):
+				valueExpr = &ast.CallExpr{Fun: astutil.NewIdent("new"), Args: []ast.Expr{syncType}}
+
+			case len(field.Names) > 0 && g.defaultValueExprOf(field) != nil:
+				valueExpr = g.defaultValueExprOf(field)
+
+			default:
+				valueExpr = astutil.NewSelectorExpr(astutil.NewIdent("zero"), astutil.NewIdent(name))
+				usesZero = true
+			}
+
+			stmts = append(stmts, astutil.NewAssignStmt(
+				[]ast.Expr{
+					astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(name)),
+				},
+				token.ASSIGN,
+				[]ast.Expr{
+					valueExpr,
+				},
+			))
+		}
+	}
+
+	if usesZero {
+		stmts = append([]ast.Stmt{g.buildVarStmt("zero", astutil.NewIdent(structName))}, stmts...)
+	}
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("Reset"),
+		Type: astutil.NewFuncType(nil, nil, nil),
+		Body: astutil.NewBlockStmt(stmts),
+	}
+}
+
+func isSyncOnceType(fieldType ast.Expr) bool {
+	selectorExpr, ok := fieldType.(*ast.SelectorExpr)
+
+	return ok && isIdentType(selectorExpr.X, "sync") && selectorExpr.Sel.Name == "Once"
+}
@@ -0,0 +1,108 @@
+package generator
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResetFuncDecl(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name      string
+		fieldType ast.Expr
+		tag       string
+		want      string
+	}{
+		{
+			name:      "success: default value",
+			fieldType: &ast.Ident{Name: "string"},
+			tag:       "`property:\"reset\" default:\"guest\"`",
+			want:      "func (t *TestStruct) ResetValue() {\n\tt.value = \"guest\"\n}",
+		},
+		{
+			name:      "success: zero value literal",
+			fieldType: &ast.Ident{Name: "int"},
+			tag:       "`property:\"reset\"`",
+			want:      "func (t *TestStruct) ResetValue() {\n\tt.value = 0\n}",
+		},
+		{
+			name:      "success: nil",
+			fieldType: &ast.MapType{Key: &ast.Ident{Name: "string"}, Value: &ast.Ident{Name: "int"}},
+			tag:       "`property:\"reset\"`",
+			want:      "func (t *TestStruct) ResetValue() {\n\tt.value = nil\n}",
+		},
+		{
+			name:      "success: zero variable",
+			fieldType: &ast.SelectorExpr{X: &ast.Ident{Name: "time"}, Sel: &ast.Ident{Name: "Time"}},
+			tag:       "`property:\"reset\"`",
+			want:      "func (t *TestStruct) ResetValue() {\n\tvar zero time.Time\n\tt.value = zero\n}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			field := &ast.Field{
+				Names: []*ast.Ident{{Name: "value"}},
+				Type:  tt.fieldType,
+				Tag:   &ast.BasicLit{Value: tt.tag},
+			}
+
+			funcDecl, ok := generator.resetFuncDecl("TestStruct", field).(*ast.FuncDecl)
+			require.True(t, ok)
+			assert.Equal(t, "ResetValue restores the default or zero value of the value.\n", funcDecl.Doc.Text())
+
+			funcDecl.Doc = nil
+
+			buffer := bytes.NewBuffer([]byte{})
+			require.NoError(t, format.Node(buffer, token.NewFileSet(), funcDecl))
+			assert.Equal(t, tt.want, buffer.String())
+		})
+	}
+}
+
+func TestIsSyncOnceType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		fieldType ast.Expr
+		want      bool
+	}{
+		{
+			name:      "success: sync.Once",
+			fieldType: &ast.SelectorExpr{X: &ast.Ident{Name: "sync"}, Sel: &ast.Ident{Name: "Once"}},
+			want:      true,
+		},
+		{
+			name:      "success: sync.Mutex",
+			fieldType: &ast.SelectorExpr{X: &ast.Ident{Name: "sync"}, Sel: &ast.Ident{Name: "Mutex"}},
+			want:      false,
+		},
+		{
+			name:      "success: ident",
+			fieldType: &ast.Ident{Name: "Once"},
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, isSyncOnceType(tt.fieldType))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/hidori/go-astutil"
+	"github.com/pkg/errors"
+)
+
+func (g *Generator) snapshotDecls(structName string, fieldList *ast.FieldList) ([]ast.Decl, error) {
+	fields := g.propertyFields(fieldList, nil)
+	if len(fields) < 1 {
+		return nil, errors.Wrapf(errInvalidTypeDirective, "snapshot requires property fields: type=%s", structName)
+	}
+
+	snapshotName := structName + "Snapshot"
+
+	snapshotStmts := []ast.Stmt{
+		g.buildVarStmt("s", astutil.NewIdent(snapshotName)),
+	}
+
+	for _, field := range fields {
+		snapshotStmts = append(snapshotStmts, astutil.NewAssignStmt(
+			[]ast.Expr{
+				astutil.NewSelectorExpr(astutil.NewIdent("s"), astutil.NewIdent(g.prepareFieldName(field.Names[0].Name))),
+			},
+			token.ASSIGN,
+			[]ast.Expr{
+				g.buildCopyExpr(astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name)), field.Type),
+			},
+		))
+	}
+
+	snapshotStmts = append(snapshotStmts, astutil.NewReturnStmt([]ast.Expr{astutil.NewIdent("s")}))
+
+	return []ast.Decl{
+		&ast.GenDecl{
+			Tok: token.TYPE,
+			Specs: []ast.Spec{
+				&ast.TypeSpec{
+					Name: astutil.NewIdent(snapshotName),
+					Type: g.buildCodecStructType(fields, nil),
+				},
+			},
+		},
+		&ast.FuncDecl{
+			Recv: g.buildRecvFieldList(structName),
+			Name: astutil.NewIdent("Snapshot"),
+			Type: astutil.NewFuncType(
+				nil,
+				nil,
+				astutil.NewFieldList(
+					[]*ast.Field{
+						astutil.NewField(nil, astutil.NewIdent(snapshotName)),
+					},
+				),
+			),
+			Body: astutil.NewBlockStmt(snapshotStmts),
+		},
+		&ast.FuncDecl{
+			Recv: g.buildRecvFieldList(structName),
+			Name: astutil.NewIdent("Restore"),
+			Type: astutil.NewFuncType(
+				nil,
+				astutil.NewFieldList(
+					[]*ast.Field{
+						astutil.NewField(
+							[]*ast.Ident{
+								astutil.NewIdent("s"),
+							},
+							astutil.NewIdent(snapshotName),
+						),
+					},
+				),
+				astutil.NewFieldList(
+					[]*ast.Field{
+						astutil.NewField(nil, astutil.NewIdent("error")),
+					},
+				),
+			),
+			Body: astutil.NewBlockStmt(g.buildAssignFieldsStmts(fields, func(field *ast.Field) ast.Expr {
+				valueExpr := astutil.NewSelectorExpr(astutil.NewIdent("s"), astutil.NewIdent(g.prepareFieldName(field.Names[0].Name)))
+
+				return g.buildCopyExpr(valueExpr, field.Type)
+			}, nil)),
+		},
+	}, nil
+}
+
+// buildCopyExpr copies slices and maps with slices.Clone and maps.Clone, so that a snapshot and the struct
+// do not share their elements. Elements are not copied deeply.
+func (g *Generator) buildCopyExpr(x ast.Expr, fieldType ast.Expr) ast.Expr {
+	pkgName := ""
+
+	switch t := fieldType.(type) {
+	case *ast.ArrayType:
+		if t.Len == nil {
+			pkgName = "slices"
+		}
+
+	case *ast.MapType:
+		pkgName = "maps"
+	}
+
+	if pkgName == "" {
+		return x
+	}
+
+	return &ast.CallExpr{
+		Fun:  astutil.NewSelectorExpr(astutil.NewIdent(pkgName), astutil.NewIdent("Clone")),
+		Args: []ast.Expr{x},
+	}
+}
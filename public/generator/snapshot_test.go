@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotDecls(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name      string
+		fieldList *ast.FieldList
+		wantErr   bool
+	}{
+		{
+			name: "success: property fields",
+			fieldList: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{{Name: "value"}},
+						Type:  &ast.Ident{Name: "string"},
+						Tag:   &ast.BasicLit{Value: "`property:\"get\"`"},
+					},
+				},
+			},
+		},
+		{
+			name: "failure: no property fields",
+			fieldList: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{{Name: "value"}},
+						Type:  &ast.Ident{Name: "string"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decls, err := generator.snapshotDecls("TestStruct", tt.fieldList)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errInvalidTypeDirective)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, decls, 3)
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/hidori/go-astutil"
+	"github.com/pkg/errors"
+)
+
+const dbTagName = "db"
+
+func (g *Generator) sqlFuncDecls(structName string, fieldList *ast.FieldList) ([]ast.Decl, error) {
+	fields := g.propertyFields(fieldList, func(field *ast.Field) bool {
+		return g.columnNameOf(field) != ""
+	})
+	if len(fields) < 1 {
+		return nil, errors.Wrapf(errInvalidTypeDirective, "sql requires property fields with db tag: type=%s", structName)
+	}
+
+	var writableFields []*ast.Field
+
+	for _, field := range fields {
+		if !g.isReadonlyColumn(field) {
+			writableFields = append(writableFields, field)
+		}
+	}
+
+	return []ast.Decl{
+		g.columnsFuncDecl(structName, "Columns", fields),
+		g.columnsFuncDecl(structName, "WriteColumns", writableFields),
+		g.valuesFuncDecl(structName, writableFields),
+		g.scanRowFuncDecl(structName, fields),
+	}, nil
+}
+
+func (g *Generator) columnNameOf(field *ast.Field) string {
+	name, _, _ := strings.Cut(structTag(field).Get(dbTagName), ",")
+	if name == "-" {
+		return ""
+	}
+
+	return name
+}
+
+// isReadonlyColumn reports whether the column is written by the database, e.g. db:"created_at,readonly".
+func (g *Generator) isReadonlyColumn(field *ast.Field) bool {
+	_, options, _ := strings.Cut(structTag(field).Get(dbTagName), ",")
+
+	for _, option := range strings.Split(options, ",") {
+		if option == "readonly" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *Generator) columnsFuncDecl(structName string, funcName string, fields []*ast.Field) ast.Decl {
+	var elts []ast.Expr
+
+	for _, field := range fields {
+		elts = append(elts, astutil.NewBasicLit(token.STRING, strconv.Quote(g.columnNameOf(field))))
+	}
+
+	return g.buildSliceFuncDecl(structName, funcName, astutil.NewIdent("string"), elts)
+}
+
+func (g *Generator) valuesFuncDecl(structName string, fields []*ast.Field) ast.Decl {
+	var elts []ast.Expr
+
+	for _, field := range fields {
+		elts = append(elts, astutil.NewSelectorExpr(g.recvIdent(), astutil.NewIdent(field.Names[0].Name)))
+	}
+
+	return g.buildSliceFuncDecl(structName, "Values", astutil.NewIdent("any"), elts)
+}
+
+func (g *Generator) buildSliceFuncDecl(structName string, funcName string, eltType ast.Expr, elts []ast.Expr) ast.Decl {
+	sliceType := &ast.ArrayType{Elt: eltType}
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent(funcName),
+		Type: astutil.NewFuncType(
+			nil,
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(nil, sliceType),
+				},
+			),
+		),
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				astutil.NewReturnStmt(
+					[]ast.Expr{
+						&ast.CompositeLit{
+							Type: sliceType,
+							Elts: elts,
+						},
+					},
+				),
+			},
+		),
+	}
+}
+
+func (g *Generator) scanRowFuncDecl(structName string, fields []*ast.Field) ast.Decl {
+	var args []ast.Expr
+
+	for _, field := range fields {
+		args = append(args, &ast.UnaryExpr{
+			Op: token.AND,
+			X:  astutil.NewSelectorExpr(astutil.NewIdent("v"), astutil.NewIdent(g.prepareFieldName(field.Names[0].Name))),
+		})
+	}
+
+	stmts := []ast.Stmt{
+		g.buildCodecVarStmt(g.buildCodecStructType(fields, nil)),
+		g.buildReturnIfErrStmt(&ast.CallExpr{
+			Fun:  astutil.NewSelectorExpr(astutil.NewIdent("scanner"), astutil.NewIdent("Scan")),
+			Args: args,
+		}),
+	}
+
+	return &ast.FuncDecl{
+		Recv: g.buildRecvFieldList(structName),
+		Name: astutil.NewIdent("ScanRow"),
+		Type: astutil.NewFuncType(
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(
+						[]*ast.Ident{
+							astutil.NewIdent("scanner"),
+						},
+						g.buildScannerType(),
+					),
+				},
+			),
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(nil, astutil.NewIdent("error")),
+				},
+			),
+		),
+		Body: astutil.NewBlockStmt(append(stmts, g.buildRestoreStmts(fields, astutil.NewIdent("v"))...)),
+	}
+}
+
+// buildScannerType returns interface{ Scan(...any) error }.
+// The braces carry a position so that the printer keeps the interface on one line.
+func (g *Generator) buildScannerType() ast.Expr {
+	return &ast.InterfaceType{
+		Methods: &ast.FieldList{
+			Opening: token.Pos(1),
+			List: []*ast.Field{
+				astutil.NewField(
+					[]*ast.Ident{
+						astutil.NewIdent("Scan"),
+					},
+					astutil.NewFuncType(
+						nil,
+						astutil.NewFieldList(
+							[]*ast.Field{
+								astutil.NewField(nil, &ast.Ellipsis{Elt: astutil.NewIdent("any")}),
+							},
+						),
+						astutil.NewFieldList(
+							[]*ast.Field{
+								astutil.NewField(nil, astutil.NewIdent("error")),
+							},
+						),
+					),
+				),
+			},
+			Closing: token.Pos(1),
+		},
+	}
+}
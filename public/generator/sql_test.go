@@ -0,0 +1,158 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnNameOf(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name string
+		tag  string
+		want string
+	}{
+		{
+			name: "success: db tag",
+			tag:  "`db:\"user_id\"`",
+			want: "user_id",
+		},
+		{
+			name: "success: db tag with options",
+			tag:  "`db:\"created_at,readonly\"`",
+			want: "created_at",
+		},
+		{
+			name: "success: skipped db tag",
+			tag:  "`db:\"-\"`",
+			want: "",
+		},
+		{
+			name: "success: no db tag",
+			tag:  "`property:\"get\"`",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			field := &ast.Field{
+				Names: []*ast.Ident{{Name: "value"}},
+				Type:  &ast.Ident{Name: "string"},
+				Tag:   &ast.BasicLit{Value: tt.tag},
+			}
+
+			assert.Equal(t, tt.want, generator.columnNameOf(field))
+		})
+	}
+}
+
+func TestIsReadonlyColumn(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name string
+		tag  string
+		want bool
+	}{
+		{
+			name: "success: readonly option",
+			tag:  "`db:\"created_at,readonly\"`",
+			want: true,
+		},
+		{
+			name: "success: no options",
+			tag:  "`db:\"created_at\"`",
+			want: false,
+		},
+		{
+			name: "success: readonly as column name",
+			tag:  "`db:\"readonly\"`",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			field := &ast.Field{
+				Names: []*ast.Ident{{Name: "value"}},
+				Type:  &ast.Ident{Name: "string"},
+				Tag:   &ast.BasicLit{Value: tt.tag},
+			}
+
+			assert.Equal(t, tt.want, generator.isReadonlyColumn(field))
+		})
+	}
+}
+
+func TestSQLFuncDecls(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name      string
+		fieldList *ast.FieldList
+		wantErr   bool
+	}{
+		{
+			name: "success: property fields with db tag",
+			fieldList: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{{Name: "value"}},
+						Type:  &ast.Ident{Name: "string"},
+						Tag:   &ast.BasicLit{Value: "`property:\"get\" db:\"value\"`"},
+					},
+				},
+			},
+		},
+		{
+			name: "failure: no property fields with db tag",
+			fieldList: &ast.FieldList{
+				List: []*ast.Field{
+					{
+						Names: []*ast.Ident{{Name: "value"}},
+						Type:  &ast.Ident{Name: "string"},
+						Tag:   &ast.BasicLit{Value: "`property:\"get\" db:\"-\"`"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decls, err := generator.sqlFuncDecls("TestStruct", tt.fieldList)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errInvalidTypeDirective)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, decls, 4)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const typeDirectivePrefix = "//genprop:"
+
+type typeDirectives map[string][]string
+
+var typeDirectiveValidators = map[string]func(value string) bool{
+	"marshal":   oneOf("json", "text", "binary"),
+	"sql":       noValue,
+	"log":       noValue,
+	"equal":     noValue,
+	"compare":   token.IsIdentifier,
+	"clone":     noValue,
+	"interface": noValue,
+	"patch":     noValue,
+	"fields":    noValue,
+	"diff":      noValue,
+	"snapshot":  noValue,
+	"dto":       isTypeName,
+	"reset":     noValue,
+	"getter":    oneOf("value"),
+}
+
+var errInvalidTypeDirective = errors.New("invalid type directive")
+
+func oneOf(values ...string) func(value string) bool {
+	return func(value string) bool {
+		return slices.Contains(values, value)
+	}
+}
+
+func noValue(string) bool {
+	return false
+}
+
+func (g *Generator) parseTypeDirectives(doc *ast.CommentGroup) (typeDirectives, error) {
+	directives := typeDirectives{}
+
+	if doc == nil {
+		return directives, nil
+	}
+
+	for _, comment := range doc.List {
+		text, found := strings.CutPrefix(comment.Text, typeDirectivePrefix)
+		if !found {
+			continue
+		}
+
+		name, value, _ := strings.Cut(strings.TrimSpace(text), "=")
+
+		validator, ok := typeDirectiveValidators[name]
+		if !ok {
+			return nil, errors.Wrapf(errInvalidTypeDirective, "directive=%s", name)
+		}
+
+		var values []string
+
+		if value != "" {
+			values = strings.Split(value, ",")
+		}
+
+		for _, v := range values {
+			if !validator(v) {
+				return nil, errors.Wrapf(errInvalidTypeDirective, "directive=%s value=%s", name, v)
+			}
+		}
+
+		directives[name] = append(directives[name], values...)
+	}
+
+	return directives, nil
+}
+
+func (d typeDirectives) has(name string, value string) bool {
+	return slices.Contains(d[name], value)
+}
+
+func (d typeDirectives) enabled(name string) bool {
+	_, ok := d[name]
+
+	return ok
+}
@@ -0,0 +1,166 @@
+package generator
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTypeDirectives(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name    string
+		doc     *ast.CommentGroup
+		want    typeDirectives
+		wantErr bool
+	}{
+		{
+			name: "success: nil doc returns empty",
+			doc:  nil,
+			want: typeDirectives{},
+		},
+		{
+			name: "success: ignores other comments",
+			doc: &ast.CommentGroup{
+				List: []*ast.Comment{
+					{Text: "// User represents a user."},
+					{Text: "//go:generate genprop user.go"},
+				},
+			},
+			want: typeDirectives{},
+		},
+		{
+			name: "success: marshal directive",
+			doc: &ast.CommentGroup{
+				List: []*ast.Comment{
+					{Text: "//genprop:marshal=json"},
+				},
+			},
+			want: typeDirectives{"marshal": {"json"}},
+		},
+		{
+			name: "success: multiple marshal directives are merged",
+			doc: &ast.CommentGroup{
+				List: []*ast.Comment{
+					{Text: "//genprop:marshal=text,binary"},
+					{Text: "//genprop:marshal=json"},
+				},
+			},
+			want: typeDirectives{"marshal": {"text", "binary", "json"}},
+		},
+		{
+			name: "success: sql directive",
+			doc: &ast.CommentGroup{
+				List: []*ast.Comment{
+					{Text: "//genprop:sql"},
+				},
+			},
+			want: typeDirectives{"sql": nil},
+		},
+		{
+			name: "success: equal and compare directives",
+			doc: &ast.CommentGroup{
+				List: []*ast.Comment{
+					{Text: "//genprop:equal"},
+					{Text: "//genprop:compare=lastName,firstName"},
+				},
+			},
+			want: typeDirectives{"equal": nil, "compare": {"lastName", "firstName"}},
+		},
+		{
+			name: "success: dto directive",
+			doc: &ast.CommentGroup{
+				List: []*ast.Comment{
+					{Text: "//genprop:dto=api.UserResponse,UserRow"},
+				},
+			},
+			want: typeDirectives{"dto": {"api.UserResponse", "UserRow"}},
+		},
+		{
+			name: "success: getter directive",
+			doc: &ast.CommentGroup{
+				List: []*ast.Comment{
+					{Text: "//genprop:getter=value"},
+				},
+			},
+			want: typeDirectives{"getter": {"value"}},
+		},
+		{
+			name: "failure: unknown directive",
+			doc: &ast.CommentGroup{
+				List: []*ast.Comment{
+					{Text: "//genprop:unknown"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "failure: unknown directive value",
+			doc: &ast.CommentGroup{
+				List: []*ast.Comment{
+					{Text: "//genprop:marshal=yaml"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "failure: sql directive does not take a value",
+			doc: &ast.CommentGroup{
+				List: []*ast.Comment{
+					{Text: "//genprop:sql=postgres"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "failure: compare key is not an identifier",
+			doc: &ast.CommentGroup{
+				List: []*ast.Comment{
+					{Text: "//genprop:compare=last-name"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "failure: dto directive with invalid type name",
+			doc: &ast.CommentGroup{
+				List: []*ast.Comment{
+					{Text: "//genprop:dto=api.v1.UserResponse"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "failure: getter directive with unknown receiver kind",
+			doc: &ast.CommentGroup{
+				List: []*ast.Comment{
+					{Text: "//genprop:getter=pointer"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := generator.parseTypeDirectives(tt.doc)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errInvalidTypeDirective)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
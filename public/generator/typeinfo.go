@@ -0,0 +1,143 @@
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"github.com/hidori/go-astutil"
+	"github.com/hidori/go-typeutil"
+)
+
+var intBitSizes = map[string]int{
+	"int":   strconv.IntSize,
+	"int8":  8,
+	"int16": 16,
+	"int32": 32,
+	"int64": 64,
+	"rune":  32,
+}
+
+var uintBitSizes = map[string]int{
+	"uint":    strconv.IntSize,
+	"uint8":   8,
+	"uint16":  16,
+	"uint32":  32,
+	"uint64":  64,
+	"uintptr": 64,
+	"byte":    8,
+}
+
+var floatBitSizes = map[string]int{
+	"float32": 32,
+	"float64": 64,
+}
+
+func isIdentType(fieldType ast.Expr, name string) bool {
+	ident := typeutil.AsOrEmpty[*ast.Ident](fieldType)
+
+	return ident != nil && ident.Name == name
+}
+
+func isStringPointerType(fieldType ast.Expr) bool {
+	starExpr := typeutil.AsOrEmpty[*ast.StarExpr](fieldType)
+
+	return starExpr != nil && isIdentType(starExpr.X, "string")
+}
+
+func isNumericType(fieldType ast.Expr) bool {
+	ident := typeutil.AsOrEmpty[*ast.Ident](fieldType)
+	if ident == nil {
+		return isDurationType(fieldType)
+	}
+
+	_, isInt := intBitSizes[ident.Name]
+	_, isUint := uintBitSizes[ident.Name]
+	_, isFloat := floatBitSizes[ident.Name]
+
+	return isInt || isUint || isFloat
+}
+
+func isDurationType(fieldType ast.Expr) bool {
+	selectorExpr := typeutil.AsOrEmpty[*ast.SelectorExpr](fieldType)
+	if selectorExpr == nil {
+		return false
+	}
+
+	return isIdentType(selectorExpr.X, "time") && selectorExpr.Sel.Name == "Duration"
+}
+
+func isTimeType(fieldType ast.Expr) bool {
+	selectorExpr := typeutil.AsOrEmpty[*ast.SelectorExpr](fieldType)
+	if selectorExpr == nil {
+		return false
+	}
+
+	return isIdentType(selectorExpr.X, "time") && selectorExpr.Sel.Name == "Time"
+}
+
+func isNillableType(fieldType ast.Expr) bool {
+	switch t := fieldType.(type) {
+	case *ast.StarExpr, *ast.MapType, *ast.ChanType, *ast.FuncType, *ast.InterfaceType:
+		return true
+
+	case *ast.ArrayType:
+		return t.Len == nil
+
+	case *ast.Ident:
+		return t.Name == "any" || t.Name == "error"
+
+	default:
+		return false
+	}
+}
+
+func (g *Generator) buildZeroCheckExpr(x ast.Expr, fieldType ast.Expr) ast.Expr {
+	switch {
+	case isIdentType(fieldType, "string"):
+		return &ast.BinaryExpr{
+			Op: token.EQL,
+			X:  x,
+			Y:  astutil.NewBasicLit(token.STRING, `""`),
+		}
+
+	case isIdentType(fieldType, "bool"):
+		return &ast.UnaryExpr{
+			Op: token.NOT,
+			X:  x,
+		}
+
+	case isNumericType(fieldType):
+		return &ast.BinaryExpr{
+			Op: token.EQL,
+			X:  x,
+			Y:  astutil.NewBasicLit(token.INT, "0"),
+		}
+
+	case isNillableType(fieldType):
+		return &ast.BinaryExpr{
+			Op: token.EQL,
+			X:  x,
+			Y:  astutil.NewIdent("nil"),
+		}
+
+	default:
+		return nil
+	}
+}
+
+func (g *Generator) zeroValueExpr(fieldType ast.Expr) ast.Expr {
+	switch {
+	case isIdentType(fieldType, "string"):
+		return astutil.NewBasicLit(token.STRING, `""`)
+
+	case isIdentType(fieldType, "bool"):
+		return astutil.NewIdent("false")
+
+	case isNumericType(fieldType):
+		return astutil.NewBasicLit(token.INT, "0")
+
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,86 @@
+package generator
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildZeroCheckExpr(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName: tagName,
+	})
+
+	tests := []struct {
+		name      string
+		fieldType ast.Expr
+		want      string
+		wantNil   bool
+	}{
+		{
+			name:      "success: string",
+			fieldType: &ast.Ident{Name: "string"},
+			want:      `x == ""`,
+		},
+		{
+			name:      "success: bool",
+			fieldType: &ast.Ident{Name: "bool"},
+			want:      "!x",
+		},
+		{
+			name:      "success: numeric",
+			fieldType: &ast.Ident{Name: "float32"},
+			want:      "x == 0",
+		},
+		{
+			name:      "success: time.Duration",
+			fieldType: &ast.SelectorExpr{X: &ast.Ident{Name: "time"}, Sel: &ast.Ident{Name: "Duration"}},
+			want:      "x == 0",
+		},
+		{
+			name:      "success: slice",
+			fieldType: &ast.ArrayType{Elt: &ast.Ident{Name: "int"}},
+			want:      "x == nil",
+		},
+		{
+			name:      "success: map",
+			fieldType: &ast.MapType{Key: &ast.Ident{Name: "string"}, Value: &ast.Ident{Name: "int"}},
+			want:      "x == nil",
+		},
+		{
+			name:      "success: array returns nil",
+			fieldType: &ast.ArrayType{Len: &ast.BasicLit{Kind: token.INT, Value: "4"}, Elt: &ast.Ident{Name: "int"}},
+			wantNil:   true,
+		},
+		{
+			name:      "success: named type returns nil",
+			fieldType: &ast.Ident{Name: "OtherStruct"},
+			wantNil:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			expr := generator.buildZeroCheckExpr(&ast.Ident{Name: "x"}, tt.fieldType)
+
+			if tt.wantNil {
+				assert.Nil(t, expr)
+				return
+			}
+
+			got := bytes.NewBuffer([]byte{})
+
+			require.NoError(t, format.Node(got, token.NewFileSet(), expr))
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
@@ -0,0 +1,282 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hidori/go-astutil"
+	"github.com/hidori/go-typeutil"
+)
+
+// GenerateTests generates unit tests that exercise the getter and setter methods generated for the given file.
+func (g *Generator) GenerateTests(fileSet *token.FileSet, file *ast.File) ([]ast.Decl, error) {
+	var decls []ast.Decl
+
+	for _, d := range file.Decls {
+		genDecl := typeutil.AsOrEmpty[*ast.GenDecl](d)
+
+		if genDecl == nil {
+			continue
+		}
+
+		if genDecl.Tok == token.IMPORT {
+			decls = append(decls, genDecl)
+
+			continue
+		}
+
+		if genDecl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, s := range genDecl.Specs {
+			typeSpec := typeutil.AsOrEmpty[*ast.TypeSpec](s)
+			if typeSpec == nil {
+				continue
+			}
+
+			structType := typeutil.AsOrEmpty[*ast.StructType](typeSpec.Type)
+			if structType == nil {
+				continue
+			}
+
+			for _, field := range g.propertyFields(structType.Fields, nil) {
+				decl := g.accessorTestFuncDecl(typeSpec.Name.Name, field)
+				if decl != nil {
+					decls = append(decls, decl)
+				}
+			}
+		}
+	}
+
+	return decls, nil
+}
+
+func (g *Generator) getterNameOf(field *ast.Field) (string, bool) {
+	switch {
+	case g.hasDirective(field, "get"):
+		return "Get" + g.prepareFieldName(field.Names[0].Name), true
+
+	case g.hasDirective(field, "is"):
+		return "Is" + g.prepareFieldName(field.Names[0].Name), true
+
+	default:
+		return "", false
+	}
+}
+
+func (g *Generator) accessorTestFuncDecl(structName string, field *ast.Field) ast.Decl {
+	getterName, hasGetter := g.getterNameOf(field)
+	setterName, hasSetter := g.setterNameOf(field)
+
+	if !hasGetter && !hasSetter {
+		return nil
+	}
+
+	stmts := []ast.Stmt{
+		astutil.NewAssignStmt(
+			[]ast.Expr{astutil.NewIdent("target")},
+			token.DEFINE,
+			[]ast.Expr{
+				&ast.UnaryExpr{
+					Op: token.AND,
+					X:  &ast.CompositeLit{Type: astutil.NewIdent(structName)},
+				},
+			},
+		),
+	}
+
+	if g.isRoundTripTestable(field) {
+		stmts = append(stmts, g.buildRoundTripTestStmts(field, getterName, setterName)...)
+	} else {
+		stmts = append(stmts, g.buildCallTestStmts(field, getterName, setterName)...)
+	}
+
+	return &ast.FuncDecl{
+		Name: astutil.NewIdent("Test" + structName + "_" + g.prepareFieldName(field.Names[0].Name)),
+		Type: astutil.NewFuncType(
+			nil,
+			astutil.NewFieldList(
+				[]*ast.Field{
+					astutil.NewField(
+						[]*ast.Ident{
+							astutil.NewIdent("t"),
+						},
+						astutil.NewStarExpr(astutil.NewSelectorExpr(astutil.NewIdent("testing"), astutil.NewIdent("T"))),
+					),
+				},
+			),
+			nil,
+		),
+		Body: astutil.NewBlockStmt(stmts),
+	}
+}
+
+// isRoundTripTestable reports whether a value set to the field can be expected back unchanged.
+func (g *Generator) isRoundTripTestable(field *ast.Field) bool {
+	if g.sampleValueExpr(field.Type) == nil || g.hasValidation(field) {
+		return false
+	}
+
+	if structTag(field).Get(normalizeTagName) != "" {
+		return false
+	}
+
+	_, hasBefore := g.directiveValue(field, "before")
+	_, hasLazy := g.directiveValue(field, "lazy")
+
+	return !hasBefore && !hasLazy
+}
+
+func (g *Generator) buildRoundTripTestStmts(field *ast.Field, getterName string, setterName string) []ast.Stmt {
+	fieldExpr := astutil.NewSelectorExpr(astutil.NewIdent("target"), astutil.NewIdent(field.Names[0].Name))
+
+	var stmts []ast.Stmt
+
+	if setterName != "" {
+		stmts = append(stmts, &ast.ExprStmt{
+			X: &ast.CallExpr{
+				Fun:  astutil.NewSelectorExpr(astutil.NewIdent("target"), astutil.NewIdent(setterName)),
+				Args: []ast.Expr{g.sampleValueExpr(field.Type)},
+			},
+		})
+	} else {
+		stmts = append(stmts, astutil.NewAssignStmt(
+			[]ast.Expr{fieldExpr},
+			token.ASSIGN,
+			[]ast.Expr{g.sampleValueExpr(field.Type)},
+		))
+	}
+
+	gotExpr := ast.Expr(fieldExpr)
+	label := field.Names[0].Name
+
+	if getterName != "" {
+		gotExpr = &ast.CallExpr{
+			Fun: astutil.NewSelectorExpr(astutil.NewIdent("target"), astutil.NewIdent(getterName)),
+		}
+		label = getterName + "()"
+	}
+
+	return append(stmts, &ast.IfStmt{
+		Init: astutil.NewAssignStmt(
+			[]ast.Expr{astutil.NewIdent("got")},
+			token.DEFINE,
+			[]ast.Expr{gotExpr},
+		),
+		Cond: &ast.BinaryExpr{
+			Op: token.NEQ,
+			X:  astutil.NewIdent("got"),
+			Y:  g.sampleValueExpr(field.Type),
+		},
+		Body: astutil.NewBlockStmt(
+			[]ast.Stmt{
+				g.buildTestErrorStmt(
+					"Errorf",
+					fmt.Sprintf("%s = %%v, want %%v", label),
+					astutil.NewIdent("got"),
+					g.sampleValueExpr(field.Type),
+				),
+			},
+		),
+	})
+}
+
+func (g *Generator) buildCallTestStmts(field *ast.Field, getterName string, setterName string) []ast.Stmt {
+	var stmts []ast.Stmt
+
+	if setterName != "" {
+		zeroExpr := g.zeroValueExpr(field.Type)
+		if zeroExpr == nil {
+			stmts = append(stmts, g.buildVarStmt("zero", field.Type))
+			zeroExpr = astutil.NewIdent("zero")
+		}
+
+		callExpr := &ast.CallExpr{
+			Fun:  astutil.NewSelectorExpr(astutil.NewIdent("target"), astutil.NewIdent(setterName)),
+			Args: []ast.Expr{zeroExpr},
+		}
+
+		switch {
+		case g.isRequired(field):
+			stmts = append(stmts, &ast.IfStmt{
+				Init: astutil.NewAssignStmt(
+					[]ast.Expr{astutil.NewIdent("err")},
+					token.DEFINE,
+					[]ast.Expr{callExpr},
+				),
+				Cond: &ast.BinaryExpr{
+					Op: token.EQL,
+					X:  astutil.NewIdent("err"),
+					Y:  astutil.NewIdent("nil"),
+				},
+				Body: astutil.NewBlockStmt(
+					[]ast.Stmt{
+						g.buildTestErrorStmt("Error", setterName+"() accepted the zero value"),
+					},
+				),
+			})
+
+		case g.hasValidation(field):
+			stmts = append(stmts, astutil.NewAssignStmt(
+				[]ast.Expr{astutil.NewIdent("_")},
+				token.ASSIGN,
+				[]ast.Expr{callExpr},
+			))
+
+		default:
+			stmts = append(stmts, &ast.ExprStmt{X: callExpr})
+		}
+	}
+
+	if getterName != "" {
+		stmts = append(stmts, astutil.NewAssignStmt(
+			[]ast.Expr{astutil.NewIdent("_")},
+			token.ASSIGN,
+			[]ast.Expr{
+				&ast.CallExpr{
+					Fun: astutil.NewSelectorExpr(astutil.NewIdent("target"), astutil.NewIdent(getterName)),
+				},
+			},
+		))
+	}
+
+	return stmts
+}
+
+func (g *Generator) isRequired(field *ast.Field) bool {
+	if !g.hasValidation(field) || g.zeroValueExpr(field.Type) == nil {
+		return false
+	}
+
+	return slices.Contains(strings.Split(structTag(field).Get(g.config.ValidationTag), ","), "required")
+}
+
+func (g *Generator) buildTestErrorStmt(funcName string, message string, args ...ast.Expr) ast.Stmt {
+	return &ast.ExprStmt{
+		X: &ast.CallExpr{
+			Fun:  astutil.NewSelectorExpr(astutil.NewIdent("t"), astutil.NewIdent(funcName)),
+			Args: append([]ast.Expr{astutil.NewBasicLit(token.STRING, strconv.Quote(message))}, args...),
+		},
+	}
+}
+
+func (g *Generator) sampleValueExpr(fieldType ast.Expr) ast.Expr {
+	switch {
+	case isIdentType(fieldType, "string"):
+		return astutil.NewBasicLit(token.STRING, strconv.Quote("genprop"))
+
+	case isIdentType(fieldType, "bool"):
+		return astutil.NewIdent("true")
+
+	case isNumericType(fieldType):
+		return astutil.NewBasicLit(token.INT, "1")
+
+	default:
+		return nil
+	}
+}
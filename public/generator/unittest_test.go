@@ -0,0 +1,117 @@
+package generator
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator_GenerateTests(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		inputFileName  string
+		outputFileName string
+		config         *GeneratorConfig
+	}{
+		{
+			name:           "success: returns tests of getters and setters",
+			inputFileName:  "./testdata/unittest_input.go.txt",
+			outputFileName: "./testdata/unittest_output.txt",
+			config: &GeneratorConfig{
+				TagName:        tagName,
+				Initialism:     []string{"api"},
+				ValidationFunc: "validateFieldValue",
+				ValidationTag:  "validate",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fset := token.NewFileSet()
+
+			f, err := parser.ParseFile(token.NewFileSet(), tt.inputFileName, nil, parser.AllErrors|parser.ParseComments)
+			require.NoError(t, err)
+
+			got, err := NewGenerator(tt.config).GenerateTests(fset, f)
+			require.NoError(t, err)
+
+			want, err := parser.ParseFile(token.NewFileSet(), tt.outputFileName, nil, parser.AllErrors)
+			require.NoError(t, err)
+
+			_want := bytes.NewBuffer([]byte{})
+			format.Node(_want, fset, want.Decls)
+
+			_got := bytes.NewBuffer([]byte{})
+			format.Node(_got, fset, got)
+
+			assert.Equal(t, _want.String(), _got.String())
+		})
+	}
+}
+
+func TestIsRequired(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(&GeneratorConfig{
+		TagName:        tagName,
+		ValidationFunc: "validate",
+		ValidationTag:  "validate",
+	})
+
+	tests := []struct {
+		name      string
+		fieldType ast.Expr
+		tag       string
+		want      bool
+	}{
+		{
+			name:      "success: required rule",
+			fieldType: &ast.Ident{Name: "string"},
+			tag:       "`property:\"set\" validate:\"required,email\"`",
+			want:      true,
+		},
+		{
+			name:      "success: rule without required",
+			fieldType: &ast.Ident{Name: "string"},
+			tag:       "`property:\"set\" validate:\"required_if=x,max=20\"`",
+			want:      false,
+		},
+		{
+			name:      "success: no rule",
+			fieldType: &ast.Ident{Name: "string"},
+			tag:       "`property:\"set\"`",
+			want:      false,
+		},
+		{
+			name:      "success: type without zero literal",
+			fieldType: &ast.SelectorExpr{X: &ast.Ident{Name: "time"}, Sel: &ast.Ident{Name: "Time"}},
+			tag:       "`property:\"set\" validate:\"required\"`",
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			field := &ast.Field{
+				Names: []*ast.Ident{{Name: "value"}},
+				Type:  tt.fieldType,
+				Tag:   &ast.BasicLit{Value: tt.tag},
+			}
+
+			assert.Equal(t, tt.want, generator.isRequired(field))
+		})
+	}
+}